@@ -0,0 +1,168 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+package apiclient
+
+import (
+	"context"
+	"time"
+)
+
+//go:generate go run github.com/matryer/moq@v0.5.3 -out mock/api_mock.go -pkg mock . API
+
+//API Everything the provider calls on the Cox Edge API. The provider is configured with one, so resources can be
+//exercised against mock.APIMock instead of HTTP.
+type API interface {
+	OrganizationsAPI
+	EnvironmentsAPI
+	UsersAPI
+	RolesAPI
+	ImagesAPI
+	WorkloadsAPI
+	NetworkPolicyRulesAPI
+	SitesAPI
+	DeliveryDomainsAPI
+	CDNSettingsAPI
+	WAFSettingsAPI
+	EdgeLogicAPI
+	OriginSettingsAPI
+	FirewallRulesAPI
+	ScriptsAPI
+	TasksAPI
+}
+
+var _ API = (*Client)(nil)
+
+//OrganizationsAPI Organization lookups
+type OrganizationsAPI interface {
+	GetOrganizations(ctx context.Context) ([]Organization, error)
+	GetOrganization(ctx context.Context, id string) (*Organization, error)
+	GetOrganizationBillingInfo(ctx context.Context, id string) (*OrganizationBillingInfo, error)
+}
+
+//EnvironmentsAPI Environment CRUD and membership
+type EnvironmentsAPI interface {
+	GetEnvironments(ctx context.Context) ([]Environment, error)
+	GetEnvironment(ctx context.Context, id string) (*Environment, error)
+	CreateEnvironment(ctx context.Context, newEnvironment EnvironmentCreateRequest) (*Environment, error)
+	UpdateEnvironment(ctx context.Context, EnvironmentId string, newEnvironment EnvironmentCreateRequest) (*Environment, error)
+	UpdateEnvironmentMembership(ctx context.Context, EnvironmentId string, newEnvironment EnvironmentMembershipRequest) (*Environment, error)
+	UpdateEnvironmentMember(ctx context.Context, EnvironmentId string, newEnvironment EnvironmentMembersRequest) (*Environment, error)
+	DeleteEnvironment(ctx context.Context, id string) error
+}
+
+//UsersAPI User CRUD and lifecycle actions
+type UsersAPI interface {
+	GetUsers(ctx context.Context) ([]User, error)
+	GetUser(ctx context.Context, id string) (*User, error)
+	CreateUser(ctx context.Context, newUser UserCreateRequest) (*User, error)
+	UpdateUser(ctx context.Context, userId string, newUser UserCreateRequest) (*User, error)
+	DeleteUser(ctx context.Context, id string) error
+	UnlockUser(ctx context.Context, id string) error
+}
+
+//RolesAPI Role CRUD
+type RolesAPI interface {
+	GetRoles(ctx context.Context) ([]Roles, error)
+	GetRole(ctx context.Context, id string) (*Roles, error)
+	CreateRole(ctx context.Context, newRole RoleCreateRequest) (*Roles, error)
+	UpdateRole(ctx context.Context, roleId string, newRole RoleCreateRequest) (*Roles, error)
+	DeleteRole(ctx context.Context, id string) error
+}
+
+//ImagesAPI Image lookups
+type ImagesAPI interface {
+	GetImages(ctx context.Context, environmentName string) ([]Image, error)
+	GetImage(ctx context.Context, environmentName string, id string) (*Image, error)
+}
+
+//WorkloadsAPI Workload CRUD and instances
+type WorkloadsAPI interface {
+	GetWorkloads(ctx context.Context, environmentName string, organizationId string) ([]Workload, error)
+	GetWorkload(ctx context.Context, environmentName string, id string, organizationId string) (*Workload, error)
+	CreateWorkload(ctx context.Context, newWorkload WorkloadCreateRequest, organizationId string) (*TaskStatusResponse, error)
+	UpdateWorkload(ctx context.Context, workloadId string, newWorkload WorkloadCreateRequest, organizationId string) (*TaskStatusResponse, error)
+	DeleteWorkload(ctx context.Context, environmentName string, id string, organizationId string) error
+	GetWorkloadInstances(ctx context.Context, environmentName string, organizationId string, workloadId string) ([]WorkloadInstance, error)
+}
+
+//NetworkPolicyRulesAPI Network policy rule CRUD
+type NetworkPolicyRulesAPI interface {
+	GetNetworkPolicyRules(ctx context.Context, environmentName string, organizationId string) ([]NetworkPolicyRule, error)
+	GetNetworkPolicyRuleWorkload(ctx context.Context, environmentName string, id string, organizationId string) ([]NetworkPolicyRule, error)
+	GetNetworkPolicyRule(ctx context.Context, environmentName string, id string, organizationId string) (*NetworkPolicyRule, error)
+	CreateNetworkPolicyRule(ctx context.Context, newNetworkPolicyRule NetworkPolicyRuleCreateRequest, organizationId string) ([]NetworkPolicyRule, error)
+	UpdateNetworkPolicyRule(ctx context.Context, networkPolicyRuleId string, newNetworkPolicyRule NetworkPolicyRuleCreateRequest, organizationId string) ([]NetworkPolicyRule, error)
+	DeleteNetworkPolicyRule(ctx context.Context, environmentName string, id string, organizationId string, newNetworkPolicyRule NetworkPolicyRuleCreateRequest) error
+}
+
+//SitesAPI Site CRUD
+type SitesAPI interface {
+	GetSites(ctx context.Context, environmentName string, organizationId string) ([]Site, error)
+	GetSite(ctx context.Context, environmentName string, id string, organizationId string) (*Site, error)
+	CreateSite(ctx context.Context, newSite SiteCreateRequest, organizationId string) (*TaskStatusResponse, error)
+	UpdateSite(ctx context.Context, siteId string, environmentName string, operationValue string, organizationId string) (*TaskStatusResponse, error)
+	DeleteSite(ctx context.Context, environmentName string, id string, organizationId string) error
+}
+
+//DeliveryDomainsAPI Delivery domain CRUD
+type DeliveryDomainsAPI interface {
+	GetDeliveryDomains(ctx context.Context, environmentName string, organizationId string) ([]DeliveryDomain, error)
+	GetDeliveryDomain(ctx context.Context, environmentName string, id string, organizationId string) (*DeliveryDomain, error)
+	CreateDeliveryDomain(ctx context.Context, siteId string, newDeliveryDomain DeliveryDomainCreateRequest, organizationId string) (*TaskStatusResponse, error)
+	DeleteDeliveryDomain(ctx context.Context, environmentName string, id string, organizationId string) error
+}
+
+//CDNSettingsAPI CDN settings and purges
+type CDNSettingsAPI interface {
+	GetCDNSettings(ctx context.Context, environmentName string, id string, organizationId string) (*CDNSettings, error)
+	UpdateCDNSettings(ctx context.Context, cdnSettingsId string, newCDNSettings CDNSettings, organizationId string) (*TaskStatusResponse, error)
+	PurgeCDN(ctx context.Context, environmentName string, siteId string, options CDNPurgeOptions, organizationId string) (*TaskStatusResponse, error)
+}
+
+//WAFSettingsAPI WAF settings
+type WAFSettingsAPI interface {
+	GetWAFSettings(ctx context.Context, environmentName string, id string, organizationId string) (*WAFSettings, error)
+	UpdateWAFSettings(ctx context.Context, wafSettingsId string, newWAFSettings WAFSettings, organizationId string) (*TaskStatusResponse, error)
+}
+
+//EdgeLogicAPI Edge logic settings
+type EdgeLogicAPI interface {
+	GetEdgeLogic(ctx context.Context, environmentName string, id string, organizationId string) (*EdgeLogic, error)
+	UpdateEdgeLogic(ctx context.Context, edgeLogicId string, newEdgeLogic EdgeLogic, organizationId string) (*TaskStatusResponse, error)
+}
+
+//OriginSettingsAPI Origin settings
+type OriginSettingsAPI interface {
+	GetOriginSettings(ctx context.Context, environmentName string, id string, organizationId string) (*OriginSettings, error)
+	CreateOriginSettings(ctx context.Context, newOriginSettings OriginSettings) (*OriginSettings, error)
+	UpdateOriginSettings(ctx context.Context, originSettingsId string, newOriginSettings OriginSettings, organizationId string) (*OriginSettings, error)
+}
+
+//FirewallRulesAPI Firewall rule CRUD
+type FirewallRulesAPI interface {
+	GetFirewallRules(ctx context.Context, environmentName string, siteId string, organizationId string) ([]FirewallRule, error)
+	GetFirewallRule(ctx context.Context, environmentName string, siteId string, id string, organizationId string) (*FirewallRule, error)
+	CreateFirewallRule(ctx context.Context, environmentName string, newFirewallRule FirewallRule, organizationId string) (*TaskStatusResponse, error)
+	UpdateFirewallRule(ctx context.Context, environmentName string, firewallRuleId string, newFirewallRule FirewallRule, organizationId string) (*TaskStatusResponse, error)
+	DeleteFirewallRule(ctx context.Context, environmentName string, siteId string, id string, organizationId string) error
+}
+
+//ScriptsAPI Edge script CRUD
+type ScriptsAPI interface {
+	GetScripts(ctx context.Context, siteId string, environmentName string, organizationId string) ([]Script, error)
+	GetScript(ctx context.Context, id string, siteId string, environmentName string, organizationId string) (*Script, error)
+	CreateScript(ctx context.Context, siteId string, environmentName string, newScript ScriptCreateRequest, organizationId string) (*TaskStatusResponse, error)
+	UpdateScript(ctx context.Context, id string, siteId string, environmentName string, newScript ScriptCreateRequest, organizationId string) (*TaskStatusResponse, error)
+	DeleteScript(ctx context.Context, id string, siteId string, environmentName string, organizationId string) error
+}
+
+//TasksAPI Asynchronous task polling
+type TasksAPI interface {
+	GetTaskStatus(ctx context.Context, taskId string) (*TaskStatus, error)
+	AwaitTaskResolve(ctx context.Context, taskId string, minInterval time.Duration, maxInterval time.Duration, timeout time.Duration) (*TaskStatus, error)
+	AwaitTaskResolveWithTimeout(ctx context.Context, taskId string, timeout time.Duration) (*TaskStatus, error)
+	AwaitTaskResolveWithDefaults(ctx context.Context, taskId string) (*TaskStatus, error)
+}
@@ -0,0 +1,112 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+package apiclient
+
+import (
+	"sync"
+)
+
+//listCacheKey A collection of one kind of objects in an environment, site and organization
+type listCacheKey struct {
+	kind            string
+	environmentName string
+	siteId          string
+	organizationId  string
+}
+
+//listCache Collections fetched once and shared by the reads of their objects. Objects written through the client
+//are read directly afterwards, since their task may still be running when the collection is fetched again.
+type listCache struct {
+	mu      sync.Mutex
+	entries map[listCacheKey]*listCacheEntry
+	written map[listCacheKey]map[string]bool
+}
+
+type listCacheEntry struct {
+	mu      sync.Mutex
+	loaded  bool
+	objects map[string]interface{}
+}
+
+func newListCache() *listCache {
+	return &listCache{
+		entries: map[listCacheKey]*listCacheEntry{},
+		written: map[listCacheKey]map[string]bool{},
+	}
+}
+
+//EnableListCache Serve reads of firewall rules, scripts and delivery domains from their collection, fetched once
+//per environment, site and organization. Writes through the client drop the collection. Copies of the client made
+//afterwards share the cache.
+func (c *Client) EnableListCache() {
+	c.listCache = newListCache()
+}
+
+//entry The entry of the collection, nil when the object id was written and must be read directly
+func (lc *listCache) entry(key listCacheKey, id string) *listCacheEntry {
+	lc.mu.Lock()
+	defer lc.mu.Unlock()
+	if lc.written[key][id] {
+		return nil
+	}
+	entry, ok := lc.entries[key]
+	if !ok {
+		entry = &listCacheEntry{}
+		lc.entries[key] = entry
+	}
+	return entry
+}
+
+//invalidate Drop the collection of key after a write to the object id, empty for a new object
+func (lc *listCache) invalidate(key listCacheKey, id string) {
+	if lc == nil {
+		return
+	}
+	lc.mu.Lock()
+	defer lc.mu.Unlock()
+	delete(lc.entries, key)
+	if id != "" {
+		if lc.written[key] == nil {
+			lc.written[key] = map[string]bool{}
+		}
+		lc.written[key][id] = true
+	}
+}
+
+//cachedGet Serve the object id from the collection of key, fetched with fetch on first use. ok is false when the
+//cache is off, the object was written through the client or it is not in the collection, and it must be read directly.
+func cachedGet[T any](c *Client, key listCacheKey, id string, fetch func() ([]T, error), idOf func(T) string) (object *T, ok bool, err error) {
+	if c.listCache == nil {
+		return nil, false, nil
+	}
+	entry := c.listCache.entry(key, id)
+	if entry == nil {
+		return nil, false, nil
+	}
+
+	//Concurrent reads of the same collection wait for a single fetch
+	entry.mu.Lock()
+	defer entry.mu.Unlock()
+	if !entry.loaded {
+		objects, err := fetch()
+		if err != nil {
+			return nil, false, err
+		}
+		entry.objects = map[string]interface{}{}
+		for i := range objects {
+			entry.objects[idOf(objects[i])] = &objects[i]
+		}
+		entry.loaded = true
+	}
+
+	cached, ok := entry.objects[id].(*T)
+	if !ok {
+		return nil, false, nil
+	}
+	//Hand out a copy, callers may change the object they get
+	copied := *cached
+	return &copied, true, nil
+}
@@ -0,0 +1,130 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+package apiclient
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+const cacheTestOrg = "1b3c5d7e-0000-4000-8000-00000000000a"
+
+//newCacheTestClient A client of a server holding firewall rules a and b, counting list and single object requests
+func newCacheTestClient(t *testing.T) (*Client, map[string]int) {
+	var mu sync.Mutex
+	requests := map[string]int{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/firewallrules") && r.Method == http.MethodGet:
+			requests["list"]++
+			w.Write([]byte(`{"data":[{"id":"a","name":"listed a"},{"id":"b","name":"listed b"}],"metadata":{"recordCount":2}}`))
+		case r.Method == http.MethodGet:
+			id := r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+			requests[id]++
+			w.Write([]byte(`{"data":{"id":"` + id + `","name":"direct ` + id + `"}}`))
+		default:
+			requests[r.Method]++
+			w.Write([]byte(`{"taskId":"task-1"}`))
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	client := NewClient("test-key", server.URL, "")
+	client.SetRateLimit(RateLimit{})
+	return &client, requests
+}
+
+func getTestFirewallRule(t *testing.T, client *Client, id string) string {
+	firewallRule, err := client.GetFirewallRule(context.TODO(), "prod", "site-1", id, cacheTestOrg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return firewallRule.Name
+}
+
+func TestListCacheOff(t *testing.T) {
+	client, requests := newCacheTestClient(t)
+	getTestFirewallRule(t, client, "a")
+	getTestFirewallRule(t, client, "b")
+	if requests["list"] != 0 || requests["a"] != 1 || requests["b"] != 1 {
+		t.Errorf("expected one GET per firewall rule without the cache, got %v", requests)
+	}
+}
+
+func TestListCache(t *testing.T) {
+	client, requests := newCacheTestClient(t)
+	client.EnableListCache()
+
+	if name := getTestFirewallRule(t, client, "a"); name != "listed a" {
+		t.Errorf("expected a to come from the list, got %q", name)
+	}
+	getTestFirewallRule(t, client, "b")
+	if requests["list"] != 1 || requests["a"] != 0 || requests["b"] != 0 {
+		t.Errorf("expected a single list call, got %v", requests)
+	}
+
+	//Objects missing from the list are read directly
+	if name := getTestFirewallRule(t, client, "c"); name != "direct c" {
+		t.Errorf("expected c to be read directly, got %q", name)
+	}
+
+	//Other sites are other collections
+	if _, err := client.GetFirewallRule(context.TODO(), "prod", "site-2", "a", cacheTestOrg); err != nil {
+		t.Fatal(err)
+	}
+	if requests["list"] != 2 {
+		t.Errorf("expected the second site to be listed, got %v", requests)
+	}
+}
+
+func TestListCacheInvalidatedByWrites(t *testing.T) {
+	client, requests := newCacheTestClient(t)
+	client.EnableListCache()
+	getTestFirewallRule(t, client, "a")
+
+	_, err := client.UpdateFirewallRule(context.TODO(), "prod", "a", FirewallRule{Id: "a", SiteId: "site-1"}, cacheTestOrg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	//The updated rule is read directly, its task may still be running
+	if name := getTestFirewallRule(t, client, "a"); name != "direct a" {
+		t.Errorf("expected the updated rule to be read directly, got %q", name)
+	}
+	//The rest of the collection is listed again
+	if name := getTestFirewallRule(t, client, "b"); name != "listed b" {
+		t.Errorf("expected b to come from the list, got %q", name)
+	}
+	if requests["list"] != 2 || requests["a"] != 1 {
+		t.Errorf("expected the write to drop the list, got %v", requests)
+	}
+}
+
+func TestListCacheConcurrentReads(t *testing.T) {
+	client, requests := newCacheTestClient(t)
+	client.EnableListCache()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(id string) {
+			defer wg.Done()
+			if _, err := client.GetFirewallRule(context.TODO(), "prod", "site-1", id, cacheTestOrg); err != nil {
+				t.Error(err)
+			}
+		}([]string{"a", "b"}[i%2])
+	}
+	wg.Wait()
+	if requests["list"] != 1 {
+		t.Errorf("expected concurrent reads to share one list call, got %v", requests)
+	}
+}
@@ -6,95 +6,30 @@
 package apiclient
 
 import (
-	"bytes"
-	"encoding/json"
-	"net/http"
+	"context"
 )
 
 //GetCDNSettings Get cdnSettings in account by id
-func (c *Client) GetCDNSettings(environmentName string, id string, organizationId string) (*CDNSettings, error) {
-	//Create the request
-	request, err := http.NewRequest("GET",
-		CoxEdgeAPIBase+"/services/"+CoxEdgeServiceCode+"/"+environmentName+"/cdnsettings/"+id+"?org_id="+organizationId,
-		nil,
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	//Execute request
-	respBytes, err := c.doRequest(request)
-	if err != nil {
-		return nil, err
-	}
-
-	//Unmarshal, unwrap, and return
-	var wrappedAPIStruct WrappedCDNSettings
-	err = json.Unmarshal(respBytes, &wrappedAPIStruct)
-	if err != nil {
-		return nil, err
-	}
-	return &wrappedAPIStruct.Data, nil
+func (c *Client) GetCDNSettings(ctx context.Context, environmentName string, id string, organizationId string) (*CDNSettings, error) {
+	return get[CDNSettings](ctx, c, c.serviceURL(environmentName, "cdnsettings", id).withOrg(organizationId))
 }
 
 //UpdateCDNSettings Update a cdnSettings
-func (c *Client) UpdateCDNSettings(cdnSettingsId string, newCDNSettings CDNSettings, organizationId string) (*TaskStatusResponse, error) {
-	//Marshal the request
-	jsonBytes, err := json.Marshal(newCDNSettings)
-	if err != nil {
-		return nil, err
-	}
-	//Wrap bytes in reader
-	bReader := bytes.NewReader(jsonBytes)
-	//Create the request
-	request, err := http.NewRequest("PATCH",
-		CoxEdgeAPIBase+"/services/"+CoxEdgeServiceCode+"/"+newCDNSettings.EnvironmentName+"/cdnsettings/"+cdnSettingsId+"?org_id="+organizationId,
-		bReader,
+func (c *Client) UpdateCDNSettings(ctx context.Context, cdnSettingsId string, newCDNSettings CDNSettings, organizationId string) (*TaskStatusResponse, error) {
+	return updateTask(ctx, c, "PATCH",
+		c.serviceURL(newCDNSettings.EnvironmentName, "cdnsettings", cdnSettingsId).withOrg(organizationId),
+		newCDNSettings,
 	)
-	request.Header.Set("Content-Type", "application/json")
-	//Execute request
-	respBytes, err := c.doRequest(request)
-	if err != nil {
-		return nil, err
-	}
-	//Return struct
-	var wrappedAPIStruct TaskStatusResponse
-	err = json.Unmarshal(respBytes, &wrappedAPIStruct)
-	if err != nil {
-		return nil, err
-	}
-	return &wrappedAPIStruct, nil
 }
 
-func (c *Client) PurgeCDN(environmentName string, siteId string, options CDNPurgeOptions, organizationId string) (*TaskStatusResponse, error) {
+func (c *Client) PurgeCDN(ctx context.Context, environmentName string, siteId string, options CDNPurgeOptions, organizationId string) (*TaskStatusResponse, error) {
 	//Derive the operation type
 	operationType := "purge"
 	if len(options.Items) == 0 {
 		operationType = "purgeAll"
 	}
-	//Marshal the request
-	jsonBytes, err := json.Marshal(options)
-	if err != nil {
-		return nil, err
-	}
-	//Wrap bytes in reader
-	bReader := bytes.NewReader(jsonBytes)
-	//Create the request
-	request, err := http.NewRequest("PUT",
-		CoxEdgeAPIBase+"/services/"+CoxEdgeServiceCode+"/"+environmentName+"/cdnsettings/"+siteId+"?org_id="+organizationId+"&operation="+operationType,
-		bReader,
+	return updateTask(ctx, c, "PUT",
+		c.serviceURL(environmentName, "cdnsettings", siteId).withOrg(organizationId).with("operation", operationType),
+		options,
 	)
-	request.Header.Set("Content-Type", "application/json")
-	//Execute request
-	respBytes, err := c.doRequest(request)
-	if err != nil {
-		return nil, err
-	}
-	//Return struct
-	var wrappedAPIStruct TaskStatusResponse
-	err = json.Unmarshal(respBytes, &wrappedAPIStruct)
-	if err != nil {
-		return nil, err
-	}
-	return &wrappedAPIStruct, nil
 }
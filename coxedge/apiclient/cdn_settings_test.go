@@ -1,17 +1,46 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
 package apiclient
 
-import "testing"
+import (
+	"context"
+	"coxedge/terraform-provider/coxedge/apiclient/fake"
+	"testing"
+)
 
 func TestUpdateCDNSettings(t *testing.T) {
+	siteId := createTestSite(t, "cdn.cc.com")
 	b := true
 
 	cdn := CDNSettings{
-		EnvironmentName:     "test-codecraft",
-		SiteId:              "0e91e079-9f01-4a31-b71e-a7e8fb12ee3a",
-		Http2SupportEnabled: &b}
-	res, err := apiClient.UpdateCDNSettings("0e91e079-9f01-4a31-b71e-a7e8fb12ee3a", cdn)
+		EnvironmentName:     fake.EnvironmentName,
+		SiteId:              siteId,
+		Http2SupportEnabled: &b,
+		CacheTtl:            3600,
+	}
+	res, err := apiClient.UpdateCDNSettings(context.TODO(), siteId, cdn, fakeServer.OrganizationId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	awaitTask(t, res.TaskId)
+
+	settings, err := apiClient.GetCDNSettings(context.TODO(), fake.EnvironmentName, siteId, fakeServer.OrganizationId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if settings.Http2SupportEnabled == nil || !*settings.Http2SupportEnabled || settings.CacheTtl != 3600 {
+		t.Errorf("unexpected cdn settings %+v", settings)
+	}
+}
+
+func TestPurgeCDN(t *testing.T) {
+	siteId := createTestSite(t, "purge.cc.com")
+	res, err := apiClient.PurgeCDN(context.TODO(), fake.EnvironmentName, siteId, CDNPurgeOptions{PurgeType: "URL"}, fakeServer.OrganizationId)
 	if err != nil {
-		t.Error(err)
+		t.Fatal(err)
 	}
-	t.Logf("Got %v cdn", res.TaskId)
+	awaitTask(t, res.TaskId)
 }
@@ -6,47 +6,131 @@
 package apiclient
 
 import (
-	"fmt"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"io/ioutil"
 	"net/http"
+	"strings"
 	"time"
 )
 
+//CoxEdgeAPIBase Default API base used when none is configured
 const CoxEdgeAPIBase = "https://portal.coxedge.com/api/v2"
-const CoxEdgeServiceCode = "edge-services"
 
-//const CoxEdgeAPIBase = "https://cox.uat.cloudmc.io/api/v2"
-//const CoxEdgeServiceCode = "stackpath-cox-uat"
+//CoxEdgeServiceCode Default service code used when none is configured
+const CoxEdgeServiceCode = "edge-services"
 
 type Client struct {
-	apiKey     string
-	HTTPClient *http.Client
+	apiKey      string
+	apiBase     string
+	serviceCode string
+	HTTPClient  *http.Client
+	RetryPolicy RetryPolicy
+	UserAgent   string
+	PageSize    int
+	limiter     *limiter
+	listCache   *listCache
 }
 
-func NewClient(apiKey string) Client {
+//NewClient Create a client. Empty apiBase or serviceCode fall back to the production defaults,
+//e.g. "https://cox.uat.cloudmc.io/api/v2" and "stackpath-cox-uat" can be passed to target UAT.
+func NewClient(apiKey string, apiBase string, serviceCode string) Client {
+	if apiBase == "" {
+		apiBase = CoxEdgeAPIBase
+	}
+	if serviceCode == "" {
+		serviceCode = CoxEdgeServiceCode
+	}
 	return Client{
-		HTTPClient: &http.Client{Timeout: 10 * time.Second},
-		apiKey:     apiKey,
+		HTTPClient:  &http.Client{Timeout: DefaultRequestTimeout},
+		apiKey:      apiKey,
+		apiBase:     strings.TrimRight(apiBase, "/"),
+		serviceCode: serviceCode,
+		RetryPolicy: DefaultRetryPolicy(),
+		UserAgent:   DefaultUserAgent,
+		PageSize:    DefaultPageSize,
+		limiter:     newLimiter(DefaultRateLimit()),
 	}
 }
 
+//SetRateLimit Replace the rate limit of the client. Copies of the client made afterwards share the new limits.
+func (c *Client) SetRateLimit(rateLimit RateLimit) {
+	c.limiter = newLimiter(rateLimit)
+}
+
+//doRequest Execute the request, retrying transient failures of idempotent requests per the RetryPolicy
 func (c *Client) doRequest(req *http.Request) ([]byte, error) {
 	req.Header.Set("MC-Api-Key", c.apiKey)
+	if c.UserAgent != "" {
+		req.Header.Set("User-Agent", c.UserAgent)
+	}
+
+	maxAttempts := 1
+	if isIdempotent(req) && c.RetryPolicy.MaxAttempts > 1 {
+		maxAttempts = c.RetryPolicy.MaxAttempts
+	}
+
+	for attempt := 1; ; attempt++ {
+		body, retryAfter, err := c.attemptRequest(req)
+		if err == nil || retryAfter < 0 || attempt >= maxAttempts {
+			return body, err
+		}
+
+		timer := time.NewTimer(c.RetryPolicy.wait(attempt, retryAfter))
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+
+		//Rewind the body for the next attempt
+		if req.GetBody != nil {
+			newBody, bodyErr := req.GetBody()
+			if bodyErr != nil {
+				return nil, bodyErr
+			}
+			req.Body = newBody
+		}
+	}
+}
+
+//attemptRequest Send the request once. A negative retryAfter means the failure must not be retried.
+func (c *Client) attemptRequest(req *http.Request) ([]byte, time.Duration, error) {
+	if c.limiter != nil {
+		waited, err := c.limiter.acquire(req.Context())
+		tflog.Debug(req.Context(), "Waited for the client rate limit", "method", req.Method, "path", req.URL.Path, "wait", waited.String())
+		if err != nil {
+			return nil, -1, err
+		}
+		defer c.limiter.release()
+	}
 
+	logRequest(req)
+	start := time.Now()
 	res, err := c.HTTPClient.Do(req)
 	if err != nil {
-		return nil, err
+		logResponse(req, nil, nil, time.Since(start), err)
+		if req.Context().Err() != nil {
+			return nil, -1, err
+		}
+		return nil, 0, err
 	}
 	defer res.Body.Close()
 
 	body, err := ioutil.ReadAll(res.Body)
+	logResponse(req, res, body, time.Since(start), err)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
 	if res.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("status: %d, body: %s", res.StatusCode, body)
+		err = newAPIError(res, body)
+		if !isRetryableStatus(res.StatusCode) {
+			return nil, -1, err
+		}
+		retryAfter, _ := parseRetryAfter(res.Header.Get("Retry-After"), time.Now())
+		return nil, retryAfter, err
 	}
 
-	return body, err
+	return body, 0, nil
 }
@@ -6,9 +6,7 @@
 package apiclient
 
 import (
-	"bytes"
-	"encoding/json"
-	"net/http"
+	"context"
 )
 
 type DeliveryDomainCreateRequest struct {
@@ -16,99 +14,44 @@ type DeliveryDomainCreateRequest struct {
 	Domain          string `json:"domain"`
 }
 
-//GetDeliveryDomains Get deliveryDomains in account
-func (c *Client) GetDeliveryDomains(environmentName string, organizationId string) ([]DeliveryDomain, error) {
-	request, err := http.NewRequest("GET",
-		CoxEdgeAPIBase+"/services/"+CoxEdgeServiceCode+"/"+environmentName+"/deliverydomains?org_id="+organizationId,
-		nil,
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	respBytes, err := c.doRequest(request)
-	if err != nil {
-		return nil, err
-	}
+//GetDeliveryDomains Get deliveryDomains in account, reading every page
+func (c *Client) GetDeliveryDomains(ctx context.Context, environmentName string, organizationId string) ([]DeliveryDomain, error) {
+	return c.DeliveryDomainsPages(ctx, environmentName, organizationId).all()
+}
 
-	var wrappedAPIStruct WrappedDeliveryDomains
-	err = json.Unmarshal(respBytes, &wrappedAPIStruct)
-	if err != nil {
-		return nil, err
-	}
-	return wrappedAPIStruct.Data, nil
+//DeliveryDomainsPages Iterate deliveryDomains in account one page at a time
+func (c *Client) DeliveryDomainsPages(ctx context.Context, environmentName string, organizationId string) *PageIterator[DeliveryDomain] {
+	return newPageIterator[DeliveryDomain](ctx, c, c.serviceURL(environmentName, "deliverydomains").withOrg(organizationId))
 }
 
 //GetDeliveryDomain Get deliveryDomain in account by id
-func (c *Client) GetDeliveryDomain(environmentName string, id string, organizationId string) (*DeliveryDomain, error) {
-	//Create the request
-	request, err := http.NewRequest("GET",
-		CoxEdgeAPIBase+"/services/"+CoxEdgeServiceCode+"/"+environmentName+"/deliverydomains/"+id+"?org_id="+organizationId,
-		nil,
+func (c *Client) GetDeliveryDomain(ctx context.Context, environmentName string, id string, organizationId string) (*DeliveryDomain, error) {
+	deliveryDomain, ok, err := cachedGet(c, deliveryDomainsKey(environmentName, organizationId), id,
+		func() ([]DeliveryDomain, error) { return c.GetDeliveryDomains(ctx, environmentName, organizationId) },
+		func(deliveryDomain DeliveryDomain) string { return deliveryDomain.Id },
 	)
-	if err != nil {
-		return nil, err
-	}
-
-	//Execute request
-	respBytes, err := c.doRequest(request)
-	if err != nil {
-		return nil, err
+	if ok || err != nil {
+		return deliveryDomain, err
 	}
-
-	//Unmarshal, unwrap, and return
-	var wrappedAPIStruct WrappedDeliveryDomain
-	err = json.Unmarshal(respBytes, &wrappedAPIStruct)
-	if err != nil {
-		return nil, err
-	}
-	return &wrappedAPIStruct.Data, nil
+	return get[DeliveryDomain](ctx, c, c.serviceURL(environmentName, "deliverydomains", id).withOrg(organizationId))
 }
 
 //CreateDeliveryDomain Create the deliveryDomain
-func (c *Client) CreateDeliveryDomain(siteId string, newDeliveryDomain DeliveryDomainCreateRequest, organizationId string) (*TaskStatusResponse, error) {
-	//Marshal the request
-	jsonBytes, err := json.Marshal(newDeliveryDomain)
-	if err != nil {
-		return nil, err
-	}
-	//Wrap bytes in reader
-	bReader := bytes.NewReader(jsonBytes)
-	//Create the request
-	request, err := http.NewRequest("POST",
-		CoxEdgeAPIBase+"/services/"+CoxEdgeServiceCode+"/"+newDeliveryDomain.EnvironmentName+"/deliverydomains?siteId="+siteId+"&org_id="+organizationId,
-		bReader,
+func (c *Client) CreateDeliveryDomain(ctx context.Context, siteId string, newDeliveryDomain DeliveryDomainCreateRequest, organizationId string) (*TaskStatusResponse, error) {
+	c.listCache.invalidate(deliveryDomainsKey(newDeliveryDomain.EnvironmentName, organizationId), "")
+	return createTask(ctx, c,
+		c.serviceURL(newDeliveryDomain.EnvironmentName, "deliverydomains").withId("siteId", siteId).withOrg(organizationId),
+		newDeliveryDomain,
 	)
-	request.Header.Set("Content-Type", "application/json")
-	//Execute request
-	respBytes, err := c.doRequest(request)
-	if err != nil {
-		return nil, err
-	}
-	//Return struct
-	var taskStatusResp TaskStatusResponse
-	err = json.Unmarshal(respBytes, &taskStatusResp)
-	if err != nil {
-		return nil, err
-	}
-	return &taskStatusResp, nil
 }
 
 //DeleteDeliveryDomain Delete deliveryDomain in account by id
-func (c *Client) DeleteDeliveryDomain(environmentName string, id string, organizationId string) error {
-	//Create the request
-	request, err := http.NewRequest("DELETE",
-		CoxEdgeAPIBase+"/services/"+CoxEdgeServiceCode+"/"+environmentName+"/deliverydomains/"+id+"?org_id="+organizationId,
-		nil,
-	)
-	if err != nil {
-		return err
-	}
+func (c *Client) DeleteDeliveryDomain(ctx context.Context, environmentName string, id string, organizationId string) error {
+	c.listCache.invalidate(deliveryDomainsKey(environmentName, organizationId), id)
+	return remove(ctx, c, c.serviceURL(environmentName, "deliverydomains", id).withOrg(organizationId))
+}
 
-	//Execute request
-	_, err = c.doRequest(request)
-	if err != nil {
-		return err
-	}
-	return nil
+//deliveryDomainsKey Delivery domains are listed for a whole environment, across sites
+func deliveryDomainsKey(environmentName string, organizationId string) listCacheKey {
+	return listCacheKey{kind: "deliverydomains", environmentName: environmentName, organizationId: organizationId}
 }
@@ -0,0 +1,35 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+package apiclient
+
+import (
+	"context"
+	"coxedge/terraform-provider/coxedge/apiclient/fake"
+	"testing"
+)
+
+func TestDeliveryDomainLifecycle(t *testing.T) {
+	siteId := createTestSite(t, "domains.cc.com")
+	newDomain := DeliveryDomainCreateRequest{EnvironmentName: fake.EnvironmentName, Domain: "cdn.domains.cc.com"}
+	taskResp, err := apiClient.CreateDeliveryDomain(context.TODO(), siteId, newDomain, fakeServer.OrganizationId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	domainId := awaitTask(t, taskResp.TaskId).Data.Result.Id
+
+	domain, err := apiClient.GetDeliveryDomain(context.TODO(), fake.EnvironmentName, domainId, fakeServer.OrganizationId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if domain.Domain != "cdn.domains.cc.com" || domain.SiteId != siteId {
+		t.Errorf("unexpected delivery domain %+v", domain)
+	}
+
+	err = apiClient.DeleteDeliveryDomain(context.TODO(), fake.EnvironmentName, domainId, fakeServer.OrganizationId)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,23 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+package apiclient
+
+import (
+	"context"
+)
+
+//GetEdgeLogic Get the edge logic of a site by its id
+func (c *Client) GetEdgeLogic(ctx context.Context, environmentName string, id string, organizationId string) (*EdgeLogic, error) {
+	return get[EdgeLogic](ctx, c, c.serviceURL(environmentName, "edgelogic", id).withOrg(organizationId))
+}
+
+//UpdateEdgeLogic Update the edge logic of a site
+func (c *Client) UpdateEdgeLogic(ctx context.Context, edgeLogicId string, newEdgeLogic EdgeLogic, organizationId string) (*TaskStatusResponse, error) {
+	return updateTask(ctx, c, "PATCH",
+		c.serviceURL(newEdgeLogic.EnvironmentName, "edgelogic", edgeLogicId).withOrg(organizationId),
+		newEdgeLogic,
+	)
+}
@@ -6,9 +6,7 @@
 package apiclient
 
 import (
-	"bytes"
-	"encoding/json"
-	"net/http"
+	"context"
 )
 
 type EnvironmentCreateRequest struct {
@@ -35,164 +33,36 @@ type EnvironmentMembersRequest struct {
 }
 
 //GetEnvironments Get Environments in account
-func (c *Client) GetEnvironments() ([]Environment, error) {
-	request, err := http.NewRequest("GET", CoxEdgeAPIBase+"/environments", nil)
-	if err != nil {
-		return nil, err
-	}
-
-	respBytes, err := c.doRequest(request)
-	if err != nil {
-		return nil, err
-	}
-
-	var wrappedAPIStruct WrappedEnvironments
-	err = json.Unmarshal(respBytes, &wrappedAPIStruct)
-	if err != nil {
-		return nil, err
-	}
-	return wrappedAPIStruct.Data, nil
+func (c *Client) GetEnvironments(ctx context.Context) ([]Environment, error) {
+	return list[Environment](ctx, c, c.newURL("environments"))
 }
 
 //GetEnvironment Get Environment in account by id
-func (c *Client) GetEnvironment(id string) (*Environment, error) {
-	//Create the request
-	request, err := http.NewRequest("GET", CoxEdgeAPIBase+"/environments/"+id, nil)
-	if err != nil {
-		return nil, err
-	}
-
-	//Execute request
-	respBytes, err := c.doRequest(request)
-	if err != nil {
-		return nil, err
-	}
-
-	//Unmarshal, unwrap, and return
-	var wrappedAPIStruct WrappedEnvironment
-	err = json.Unmarshal(respBytes, &wrappedAPIStruct)
-	if err != nil {
-		return nil, err
-	}
-	return &wrappedAPIStruct.Data, nil
+func (c *Client) GetEnvironment(ctx context.Context, id string) (*Environment, error) {
+	return get[Environment](ctx, c, c.newURL("environments", id))
 }
 
 //CreateEnvironment Create the Environment
-func (c *Client) CreateEnvironment(newEnvironment EnvironmentCreateRequest) (*Environment, error) {
-	//Marshal the request
-	jsonBytes, err := json.Marshal(newEnvironment)
-	if err != nil {
-		return nil, err
-	}
-	//Wrap bytes in reader
-	bReader := bytes.NewReader(jsonBytes)
-	//Create the request
-	request, err := http.NewRequest("POST", CoxEdgeAPIBase+"/environments", bReader)
-	request.Header.Set("Content-Type", "application/json")
-	//Execute request
-	respBytes, err := c.doRequest(request)
-	if err != nil {
-		return nil, err
-	}
-	//Return struct
-	var wrappedAPIStruct WrappedEnvironment
-	err = json.Unmarshal(respBytes, &wrappedAPIStruct)
-	if err != nil {
-		return nil, err
-	}
-	return &wrappedAPIStruct.Data, nil
+func (c *Client) CreateEnvironment(ctx context.Context, newEnvironment EnvironmentCreateRequest) (*Environment, error) {
+	return create[Environment](ctx, c, c.newURL("environments"), newEnvironment)
 }
 
 //UpdateEnvironment Update a Environment
-func (c *Client) UpdateEnvironment(EnvironmentId string, newEnvironment EnvironmentCreateRequest) (*Environment, error) {
-	//Marshal the request
-	jsonBytes, err := json.Marshal(newEnvironment)
-	if err != nil {
-		return nil, err
-	}
-	//Wrap bytes in reader
-	bReader := bytes.NewReader(jsonBytes)
-	//Create the request
-	request, err := http.NewRequest("PUT", CoxEdgeAPIBase+"/environments/"+EnvironmentId, bReader)
-	request.Header.Set("Content-Type", "application/json")
-	//Execute request
-	respBytes, err := c.doRequest(request)
-	if err != nil {
-		return nil, err
-	}
-	//Return struct
-	var wrappedAPIStruct WrappedEnvironment
-	err = json.Unmarshal(respBytes, &wrappedAPIStruct)
-	if err != nil {
-		return nil, err
-	}
-	return &wrappedAPIStruct.Data, nil
+func (c *Client) UpdateEnvironment(ctx context.Context, EnvironmentId string, newEnvironment EnvironmentCreateRequest) (*Environment, error) {
+	return update[Environment](ctx, c, "PUT", c.newURL("environments", EnvironmentId), newEnvironment)
 }
 
 //UpdateEnvironmentMembership Update a Environment membership
-func (c *Client) UpdateEnvironmentMembership(EnvironmentId string, newEnvironment EnvironmentMembershipRequest) (*Environment, error) {
-	//Marshal the request
-	jsonBytes, err := json.Marshal(newEnvironment)
-	if err != nil {
-		return nil, err
-	}
-	//Wrap bytes in reader
-	bReader := bytes.NewReader(jsonBytes)
-	//Create the request
-	request, err := http.NewRequest("PUT", CoxEdgeAPIBase+"/environments/"+EnvironmentId+"/membership", bReader)
-	request.Header.Set("Content-Type", "application/json")
-	//Execute request
-	respBytes, err := c.doRequest(request)
-	if err != nil {
-		return nil, err
-	}
-	//Return struct
-	var wrappedAPIStruct WrappedEnvironment
-	err = json.Unmarshal(respBytes, &wrappedAPIStruct)
-	if err != nil {
-		return nil, err
-	}
-	return &wrappedAPIStruct.Data, nil
+func (c *Client) UpdateEnvironmentMembership(ctx context.Context, EnvironmentId string, newEnvironment EnvironmentMembershipRequest) (*Environment, error) {
+	return update[Environment](ctx, c, "PUT", c.newURL("environments", EnvironmentId, "membership"), newEnvironment)
 }
 
 //UpdateEnvironmentMember Update a Environment members
-func (c *Client) UpdateEnvironmentMember(EnvironmentId string, newEnvironment EnvironmentMembersRequest) (*Environment, error) {
-	//Marshal the request
-	jsonBytes, err := json.Marshal(newEnvironment)
-	if err != nil {
-		return nil, err
-	}
-	//Wrap bytes in reader
-	bReader := bytes.NewReader(jsonBytes)
-	//Create the request
-	request, err := http.NewRequest("POST", CoxEdgeAPIBase+"/environments/"+EnvironmentId+"/members", bReader)
-	request.Header.Set("Content-Type", "application/json")
-	//Execute request
-	respBytes, err := c.doRequest(request)
-	if err != nil {
-		return nil, err
-	}
-	//Return struct
-	var wrappedAPIStruct WrappedEnvironment
-	err = json.Unmarshal(respBytes, &wrappedAPIStruct)
-	if err != nil {
-		return nil, err
-	}
-	return &wrappedAPIStruct.Data, nil
+func (c *Client) UpdateEnvironmentMember(ctx context.Context, EnvironmentId string, newEnvironment EnvironmentMembersRequest) (*Environment, error) {
+	return update[Environment](ctx, c, "POST", c.newURL("environments", EnvironmentId, "members"), newEnvironment)
 }
 
 //DeleteEnvironment Delete Environment in account by id
-func (c *Client) DeleteEnvironment(id string) error {
-	//Create the request
-	request, err := http.NewRequest("DELETE", CoxEdgeAPIBase+"/environments/"+id, nil)
-	if err != nil {
-		return err
-	}
-
-	//Execute request
-	_, err = c.doRequest(request)
-	if err != nil {
-		return err
-	}
-	return nil
+func (c *Client) DeleteEnvironment(ctx context.Context, id string) error {
+	return remove(ctx, c, c.newURL("environments", id))
 }
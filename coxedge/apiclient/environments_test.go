@@ -6,70 +6,119 @@
 package apiclient
 
 import (
-	"fmt"
+	"context"
+	"coxedge/terraform-provider/coxedge/apiclient/fake"
 	"testing"
 )
 
-var firstEnvironmentId string
-var createdEnvironmentId string
-var serviceConnectionId string
+//createTestEnvironment Create an environment in the organization of the fake
+func createTestEnvironment(t *testing.T, name string) *Environment {
+	orgs, err := apiClient.GetOrganizations(context.TODO())
+	if err != nil {
+		t.Fatal(err)
+	}
 
-func TestEnvironmentCreate(t *testing.T) {
-	//Prep
-	orgs, err := apiClient.GetOrganizations()
+	environment := EnvironmentCreateRequest{
+		EnvironmentName:   name,
+		Description:       "This was created by the Golang API Test",
+		ServiceConnection: IdOnlyHelper{Id: orgs[0].ServiceConnections[0].Id},
+		Organization:      IdOnlyHelper{Id: orgs[0].Id},
+		Membership:        "MANY_ROLES",
+		Roles:             []Role{{Name: "Test Role", IsDefault: true}},
+	}
+	newEnvironment, err := apiClient.CreateEnvironment(context.TODO(), environment)
 	if err != nil {
-		t.Error(err)
+		t.Fatal(err)
+	}
+	return newEnvironment
+}
+
+func TestEnvironmentCreate(t *testing.T) {
+	newEnvironment := createTestEnvironment(t, "test-env-for-tfrunner")
+	if newEnvironment.Id == "" || newEnvironment.Name != "test-env-for-tfrunner" {
+		t.Errorf("unexpected environment %+v", newEnvironment)
 	}
-	if len(orgs) == 0 {
-		t.Error("Got 0 organizations")
+	if len(newEnvironment.Roles) != 1 || newEnvironment.Roles[0].Id == "" {
+		t.Errorf("expected the role to be created, got %+v", newEnvironment.Roles)
 	}
+	t.Logf("Created Environment with ID: %s\n", newEnvironment.Id)
 
-	firstOrgId = orgs[0].Id
-	for _, sc := range orgs[0].ServiceConnections {
-		if sc.Name == "Edge Compute" {
-			serviceConnectionId = sc.Id
-		}
+	//Names are unique
+	_, err := apiClient.CreateEnvironment(context.TODO(), EnvironmentCreateRequest{EnvironmentName: "test-env-for-tfrunner"})
+	if !IsConflict(err) {
+		t.Errorf("expected a conflict, got %v", err)
 	}
+}
 
-	//Test
-	environment := EnvironmentCreateRequest{
-		EnvironmentName:   "test-env-for-tfrunner",
-		Description:       "This was created by the Golang API Test",
-		ServiceConnection: IdOnlyHelper{Id: serviceConnectionId},
-		Organization:      IdOnlyHelper{Id: firstOrgId},
+func TestGetEnvironments(t *testing.T) {
+	environments, err := apiClient.GetEnvironments(context.TODO())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(environments) == 0 || environments[0].Name != fake.EnvironmentName {
+		t.Errorf("expected the seeded environment, got %+v", environments)
 	}
-	fmt.Println()
+	t.Logf("Got %d Environments\n", len(environments))
+}
 
-	newEnvironment, err := apiClient.CreateEnvironment(environment)
+func TestGetEnvironment(t *testing.T) {
+	newEnvironment := createTestEnvironment(t, "test-env-get")
+	environment, err := apiClient.GetEnvironment(context.TODO(), newEnvironment.Id)
 	if err != nil {
-		t.Error(err)
-	} else {
-		createdEnvironmentId = newEnvironment.Id
-		t.Logf("Created Environment with ID: %s\n", createdEnvironmentId)
+		t.Fatal(err)
+	}
+	if environment.Name != "test-env-get" {
+		t.Errorf("Got Environment %+v", environment)
 	}
 }
 
-func TestGetEnvironments(t *testing.T) {
-	orgs, err := apiClient.GetEnvironments()
+func TestUpdateEnvironment(t *testing.T) {
+	newEnvironment := createTestEnvironment(t, "test-env-update")
+	updated, err := apiClient.UpdateEnvironment(context.TODO(), newEnvironment.Id, EnvironmentCreateRequest{Description: "Updated"})
 	if err != nil {
-		t.Error(err)
+		t.Fatal(err)
+	}
+	if updated.Description != "Updated" || updated.Name != "test-env-update" {
+		t.Errorf("unexpected environment %+v", updated)
 	}
 
-	firstEnvironmentId = orgs[0].Id
-	t.Logf("Got %d Environments\n", len(orgs))
+	updated, err = apiClient.UpdateEnvironmentMembership(context.TODO(), newEnvironment.Id, EnvironmentMembershipRequest{Membership: "ALL_ORG_USERS"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.Membership != "ALL_ORG_USERS" {
+		t.Errorf("unexpected membership %q", updated.Membership)
+	}
 }
 
-func TestGetEnvironment(t *testing.T) {
-	org, err := apiClient.GetEnvironment(firstEnvironmentId)
+func TestUpdateEnvironmentMember(t *testing.T) {
+	newEnvironment := createTestEnvironment(t, "test-env-member")
+	users, err := apiClient.GetUsers(context.TODO())
 	if err != nil {
-		t.Error(err)
+		t.Fatal(err)
+	}
+
+	member := EnvironmentMembersRequest{
+		User: IdOnlyHelper{Id: users[0].Id},
+		Role: IdOnlyHelper{Id: newEnvironment.Roles[0].Id},
+	}
+	updated, err := apiClient.UpdateEnvironmentMember(context.TODO(), newEnvironment.Id, member)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(updated.Roles[0].Users) != 1 || updated.Roles[0].Users[0].Id != users[0].Id {
+		t.Errorf("expected the user in the role, got %+v", updated.Roles[0])
 	}
-	t.Logf("Got Environment with ID: %s\n", org.Id)
 }
 
 func TestEnvironmentDelete(t *testing.T) {
-	err := apiClient.DeleteEnvironment("")
+	newEnvironment := createTestEnvironment(t, "test-env-delete")
+	err := apiClient.DeleteEnvironment(context.TODO(), newEnvironment.Id)
 	if err != nil {
-		t.Error(err)
+		t.Fatal(err)
+	}
+	_, err = apiClient.GetEnvironment(context.TODO(), newEnvironment.Id)
+	if !IsNotFound(err) {
+		t.Errorf("expected the environment to be gone, got %v", err)
 	}
 }
@@ -0,0 +1,176 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+package apiclient
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+//APIErrorDetail A single entry of the error body returned by the API
+type APIErrorDetail struct {
+	Code    APIErrorCode `json:"code,omitempty"`
+	Message string       `json:"message,omitempty"`
+	Field   string       `json:"field,omitempty"`
+}
+
+//APIErrorCode Error codes are sent either as numbers or as strings
+type APIErrorCode string
+
+func (e *APIErrorCode) UnmarshalJSON(data []byte) error {
+	var str string
+	if err := json.Unmarshal(data, &str); err == nil {
+		*e = APIErrorCode(str)
+		return nil
+	}
+	var num json.Number
+	if err := json.Unmarshal(data, &num); err != nil {
+		return err
+	}
+	*e = APIErrorCode(num.String())
+	return nil
+}
+
+//APIError A non 200 response from the API
+type APIError struct {
+	StatusCode int
+	RequestId  string
+	Message    string
+	Errors     []APIErrorDetail
+	Body       []byte
+}
+
+func (e *APIError) Error() string {
+	var messages []string
+	if e.Message != "" {
+		messages = append(messages, e.Message)
+	}
+	for _, detail := range e.Errors {
+		if detail.Message != "" {
+			messages = append(messages, detail.Message)
+		}
+	}
+
+	errString := fmt.Sprintf("status: %d", e.StatusCode)
+	if e.RequestId != "" {
+		errString += ", request id: " + e.RequestId
+	}
+	if len(messages) > 0 {
+		return errString + ", errors: " + strings.Join(messages, "; ")
+	}
+	return errString + ", body: " + string(e.Body)
+}
+
+//newAPIError Build an APIError from the response, parsing the error body when it is JSON
+func newAPIError(res *http.Response, body []byte) *APIError {
+	apiErr := &APIError{
+		StatusCode: res.StatusCode,
+		RequestId:  res.Header.Get("X-Request-Id"),
+		Body:       body,
+	}
+	if apiErr.RequestId == "" {
+		apiErr.RequestId = res.Header.Get("Request-Id")
+	}
+
+	var errorBody struct {
+		Message string           `json:"message,omitempty"`
+		Errors  []APIErrorDetail `json:"errors,omitempty"`
+	}
+	if json.Unmarshal(body, &errorBody) == nil {
+		apiErr.Message = errorBody.Message
+		apiErr.Errors = errorBody.Errors
+	}
+	return apiErr
+}
+
+//IsNotFound Whether the error is an APIError for a missing object
+func IsNotFound(err error) bool {
+	return hasStatusCode(err, http.StatusNotFound)
+}
+
+//IsConflict Whether the error is an APIError for a conflicting change
+func IsConflict(err error) bool {
+	return hasStatusCode(err, http.StatusConflict)
+}
+
+//IsUnauthorized Whether the error is an APIError for a missing, invalid or revoked API key
+func IsUnauthorized(err error) bool {
+	return hasStatusCode(err, http.StatusUnauthorized)
+}
+
+//IsForbidden Whether the error is an APIError for an API key without access to the object
+func IsForbidden(err error) bool {
+	return hasStatusCode(err, http.StatusForbidden)
+}
+
+func hasStatusCode(err error, statusCode int) bool {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode == statusCode
+	}
+	return false
+}
+
+//TaskFailedError A task that resolved with the FAILURE status, along with the details reported by the tasks API
+type TaskFailedError struct {
+	TaskId    string
+	Reason    string
+	ErrorCode string
+	Messages  []string
+}
+
+func (e *TaskFailedError) Error() string {
+	errString := "task " + e.TaskId + " failed"
+	if e.Reason != "" {
+		errString += ": " + e.Reason
+	}
+	if e.ErrorCode != "" {
+		errString += " (code " + e.ErrorCode + ")"
+	}
+	if len(e.Messages) > 0 {
+		errString += ", messages: " + strings.Join(e.Messages, "; ")
+	}
+	return errString
+}
+
+//newTaskFailedError Collect the failure details of a task, the messages may come as plain strings or as error entries
+func newTaskFailedError(taskId string, task *TaskStatus) *TaskFailedError {
+	taskErr := &TaskFailedError{
+		TaskId:    taskId,
+		Reason:    task.Data.FailureReason,
+		ErrorCode: string(task.Data.ErrorCode),
+		Messages:  append([]string{}, task.Data.Messages...),
+	}
+	for _, detail := range task.Data.Errors {
+		if detail.Message != "" {
+			taskErr.Messages = append(taskErr.Messages, detail.Message)
+		}
+		if taskErr.ErrorCode == "" {
+			taskErr.ErrorCode = string(detail.Code)
+		}
+	}
+	return taskErr
+}
+
+//ValidationError An argument rejected by the client before any request was sent
+type ValidationError struct {
+	Field  string
+	Value  string
+	Reason string
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("invalid %s %q: %s", e.Field, e.Value, e.Reason)
+}
+
+//IsValidationError Whether the error is a ValidationError
+func IsValidationError(err error) bool {
+	var validationErr *ValidationError
+	return errors.As(err, &validationErr)
+}
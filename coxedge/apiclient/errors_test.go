@@ -0,0 +1,71 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+package apiclient
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestDoRequestReturnsAPIError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", "req-123")
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"errors":[{"code":404,"message":"Workload not found"}]}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-key", server.URL, "")
+	request, _ := http.NewRequest("GET", server.URL, nil)
+	_, err := client.doRequest(request)
+
+	apiErr, ok := err.(*APIError)
+	if !ok {
+		t.Fatalf("expected an *APIError, got %T", err)
+	}
+	if apiErr.StatusCode != 404 || apiErr.RequestId != "req-123" {
+		t.Errorf("unexpected error %+v", apiErr)
+	}
+	if len(apiErr.Errors) != 1 || apiErr.Errors[0].Code != "404" || apiErr.Errors[0].Message != "Workload not found" {
+		t.Errorf("unexpected error details %+v", apiErr.Errors)
+	}
+	if !strings.Contains(err.Error(), "Workload not found") {
+		t.Errorf("message missing from %q", err.Error())
+	}
+}
+
+func TestErrorHelpers(t *testing.T) {
+	notFound := &APIError{StatusCode: http.StatusNotFound}
+	conflict := &APIError{StatusCode: http.StatusConflict}
+	unauthorized := &APIError{StatusCode: http.StatusUnauthorized}
+	forbidden := &APIError{StatusCode: http.StatusForbidden}
+
+	if !IsNotFound(notFound) || IsNotFound(conflict) || IsNotFound(nil) {
+		t.Error("IsNotFound mismatch")
+	}
+	if !IsConflict(conflict) || IsConflict(notFound) {
+		t.Error("IsConflict mismatch")
+	}
+	if !IsUnauthorized(unauthorized) || IsUnauthorized(forbidden) {
+		t.Error("IsUnauthorized mismatch")
+	}
+	if !IsForbidden(forbidden) || IsForbidden(unauthorized) {
+		t.Error("IsForbidden mismatch")
+	}
+	if !IsNotFound(fmt.Errorf("wrapped: %w", notFound)) {
+		t.Error("IsNotFound should unwrap errors")
+	}
+}
+
+func TestAPIErrorFallsBackToRawBody(t *testing.T) {
+	err := &APIError{StatusCode: http.StatusBadGateway, Body: []byte("<html>bad gateway</html>")}
+	if err.Error() != "status: 502, body: <html>bad gateway</html>" {
+		t.Errorf("unexpected message %q", err.Error())
+	}
+}
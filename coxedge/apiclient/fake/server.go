@@ -0,0 +1,708 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+//Package fake An in-process, stateful stand-in for the CoxEdge API so the client and the provider can be tested
+//without network access or a real API key.
+package fake
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+//APIKey The only key accepted by the fake
+const APIKey = "fake-api-key"
+
+//ServiceCode The service code the fake serves edge resources under
+const ServiceCode = "edge-services"
+
+//EnvironmentName The environment seeded by NewServer
+const EnvironmentName = "test-env"
+
+//DefaultPendingPolls Number of polls a task reports PENDING before it resolves
+const DefaultPendingPolls = 1
+
+//Kinds of service objects that are created and updated through tasks
+var taskKinds = map[string]bool{
+	"sites":           true,
+	"workloads":       true,
+	"scripts":         true,
+	"firewallrules":   true,
+	"deliverydomains": true,
+}
+
+//Kinds of per site settings, created on first use
+var settingsKinds = map[string]bool{
+	"cdnsettings":    true,
+	"edgelogic":      true,
+	"wafsettings":    true,
+	"originsettings": true,
+}
+
+//Kinds of service objects that are filtered by a query parameter when listed
+var listFilters = map[string]string{
+	"scripts":            "siteId",
+	"firewallrules":      "siteId",
+	"networkpolicyrules": "workloadId",
+}
+
+type object = map[string]interface{}
+
+type record struct {
+	environment string
+	data        object
+}
+
+type task struct {
+	resultId     string
+	resultName   string
+	pendingPolls int
+	failure      string
+}
+
+//Server A stateful fake of the CoxEdge API served by httptest
+type Server struct {
+	*httptest.Server
+
+	//OrganizationId ID of the seeded organization
+	OrganizationId string
+	//ServiceConnectionId ID of the Edge Compute service connection of the seeded organization
+	ServiceConnectionId string
+	//PendingPolls Number of polls new tasks report PENDING before they resolve
+	PendingPolls int
+
+	mu          sync.Mutex
+	lastId      int
+	nextFailure string
+	objects     map[string][]*record
+	tasks       map[string]*task
+}
+
+//NewServer Start a fake seeded with an organization, roles, a user, images and the EnvironmentName environment
+func NewServer() *Server {
+	s := &Server{
+		PendingPolls: DefaultPendingPolls,
+		objects:      map[string][]*record{},
+		tasks:        map[string]*task{},
+	}
+	s.seed()
+	s.Server = httptest.NewServer(s)
+	return s
+}
+
+//LockUser Lock the user with the given id, as too many failed sign ins would
+func (s *Server) LockUser(id string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if user := s.find("users", "", id); user != nil {
+		user.data["status"] = "LOCKED"
+	}
+}
+
+//FailNextTask Make the next task created by the fake resolve with FAILURE and the given reason
+func (s *Server) FailNextTask(reason string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.nextFailure = reason
+}
+
+//ServeHTTP Route a request to the matching fake endpoint
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("MC-Api-Key") != APIKey {
+		writeError(w, http.StatusUnauthorized, "Invalid API key")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	switch parts[0] {
+	case "services":
+		s.serveService(w, r, parts[1:])
+	case "tasks":
+		s.serveTask(w, r, parts[1:])
+	case "organizations":
+		s.serveOrganizations(w, r, parts[1:])
+	case "roles":
+		s.serveRoles(w, r, parts[1:])
+	case "environments":
+		s.serveEnvironments(w, r, parts[1:])
+	case "users":
+		s.serveUsers(w, r, parts[1:])
+	default:
+		writeError(w, http.StatusNotFound, "Unknown endpoint "+r.URL.Path)
+	}
+}
+
+func (s *Server) seed() {
+	s.OrganizationId = s.newId()
+	s.ServiceConnectionId = s.newId()
+	serviceConnection := object{"id": s.ServiceConnectionId, "name": "Edge Compute", "serviceCode": ServiceCode}
+	s.add("organizations", "", object{
+		"id":                 s.OrganizationId,
+		"name":               "Fake Organization",
+		"entryPoint":         "fake",
+		"serviceConnections": []interface{}{serviceConnection},
+	})
+	s.add("roles", "", object{"id": s.newId(), "name": "Admin", "isSystem": true, "defaultScope": "ORGANIZATION"})
+	s.add("roles", "", object{"id": s.newId(), "name": "User", "isSystem": true, "defaultScope": "ENVIRONMENT"})
+	s.add("users", "", object{
+		"id":           s.newId(),
+		"userName":     "admin",
+		"firstName":    "Fake",
+		"lastName":     "Admin",
+		"email":        "admin@example.com",
+		"status":       "ACTIVE",
+		"creationDate": now(),
+		"organization": object{"id": s.OrganizationId, "name": "Fake Organization"},
+	})
+	s.add("environments", "", object{
+		"id":                s.newId(),
+		"name":              EnvironmentName,
+		"description":       "Seeded by the fake API",
+		"membership":        "MANY_USERS",
+		"creationDate":      now(),
+		"organization":      object{"id": s.OrganizationId, "name": "Fake Organization"},
+		"serviceConnection": serviceConnection,
+		"roles":             []interface{}{object{"id": s.newId(), "name": "Environment Admin", "isDefault": true}},
+	})
+	s.add("images", "", object{
+		"id":          "stackpath-edge/ubuntu-1804-bionic:v202102241556",
+		"family":      "ubuntu-1804-bionic",
+		"tag":         "v202102241556",
+		"slug":        "stackpath-edge/ubuntu-1804-bionic",
+		"status":      "READY",
+		"description": "Ubuntu 18.04 LTS",
+		"createdAt":   now(),
+	})
+}
+
+func (s *Server) serveOrganizations(w http.ResponseWriter, r *http.Request, parts []string) {
+	if len(parts) == 2 && parts[1] == "billing_info" && r.Method == http.MethodGet {
+		if s.find("organizations", "", parts[0]) == nil {
+			writeError(w, http.StatusNotFound, "Organization not found")
+			return
+		}
+		writeData(w, object{
+			"id":                    s.OrganizationId,
+			"organization":          object{"id": parts[0]},
+			"billingProvider":       object{"id": "fake-billing"},
+			"cardType":              "VISA",
+			"cardMaskedNumber":      "************4242",
+			"billingAddressCountry": "US",
+		})
+		return
+	}
+	s.serveCollection(w, r, "organizations", "", parts)
+}
+
+func (s *Server) serveEnvironments(w http.ResponseWriter, r *http.Request, parts []string) {
+	//Create, environment names are unique
+	if len(parts) == 0 && r.Method == http.MethodPost {
+		body, ok := readObject(w, r)
+		if !ok {
+			return
+		}
+		for _, existing := range s.objects["environments"] {
+			if existing.data["name"] == body["name"] {
+				writeError(w, http.StatusConflict, "An environment with this name already exists")
+				return
+			}
+		}
+		body["id"] = s.newId()
+		body["creationDate"] = now()
+		for _, role := range asList(body["roles"]) {
+			if role, ok := role.(map[string]interface{}); ok && role["id"] == nil {
+				role["id"] = s.newId()
+			}
+		}
+		writeData(w, s.add("environments", "", body).data)
+		return
+	}
+
+	if len(parts) == 2 {
+		environment := s.find("environments", "", parts[0])
+		if environment == nil {
+			writeError(w, http.StatusNotFound, "Environment not found")
+			return
+		}
+		body, ok := readObject(w, r)
+		if !ok {
+			return
+		}
+		switch {
+		case parts[1] == "membership" && r.Method == http.MethodPut:
+			environment.data["membership"] = body["membership"]
+		case parts[1] == "members" && r.Method == http.MethodPost:
+			if !addMember(environment.data, body) {
+				writeError(w, http.StatusBadRequest, "Role not found in environment")
+				return
+			}
+		default:
+			writeError(w, http.StatusNotFound, "Unknown endpoint "+r.URL.Path)
+			return
+		}
+		writeData(w, environment.data)
+		return
+	}
+	s.serveCollection(w, r, "environments", "", parts)
+}
+
+//addMember Add the user in the body to the environment role in the body, users hold a single role per environment
+func addMember(environment object, body object) bool {
+	user, _ := body["user"].(map[string]interface{})
+	role, _ := body["role"].(map[string]interface{})
+	for _, item := range asList(environment["roles"]) {
+		existing, ok := item.(map[string]interface{})
+		if ok && role != nil && existing["id"] == role["id"] {
+			removeMember(environment, user["id"])
+			existing["users"] = append(asList(existing["users"]), object{"id": user["id"]})
+			return true
+		}
+	}
+	return false
+}
+
+//removeMember Remove the user from every role of the environment
+func removeMember(environment object, userId interface{}) {
+	for _, item := range asList(environment["roles"]) {
+		existing, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		users := []interface{}{}
+		for _, user := range asList(existing["users"]) {
+			if member, ok := user.(map[string]interface{}); ok && member["id"] == userId {
+				continue
+			}
+			users = append(users, user)
+		}
+		existing["users"] = users
+	}
+}
+
+//serveRoles Custom roles can be created, changed and deleted, system roles only read
+func (s *Server) serveRoles(w http.ResponseWriter, r *http.Request, parts []string) {
+	if len(parts) == 0 && r.Method == http.MethodPost {
+		body, ok := readObject(w, r)
+		if !ok {
+			return
+		}
+		body["id"] = s.newId()
+		body["isSystem"] = false
+		writeData(w, s.add("roles", "", body).data)
+		return
+	}
+	if len(parts) == 1 && r.Method != http.MethodGet {
+		if role := s.find("roles", "", parts[0]); role != nil && role.data["isSystem"] == true {
+			writeError(w, http.StatusBadRequest, "System roles cannot be changed")
+			return
+		}
+	}
+	s.serveCollection(w, r, "roles", "", parts)
+}
+
+func (s *Server) serveUsers(w http.ResponseWriter, r *http.Request, parts []string) {
+	if len(parts) == 0 && r.Method == http.MethodPost {
+		body, ok := readObject(w, r)
+		if !ok {
+			return
+		}
+		body["id"] = s.newId()
+		body["status"] = "ACTIVE"
+		body["creationDate"] = now()
+		writeData(w, s.add("users", "", body).data)
+		return
+	}
+	if len(parts) == 2 && parts[1] == "unlock" {
+		if r.Method != http.MethodDelete {
+			writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+			return
+		}
+		user := s.find("users", "", parts[0])
+		if user == nil {
+			writeError(w, http.StatusNotFound, "User not found")
+			return
+		}
+		user.data["status"] = "ACTIVE"
+		writeData(w, user.data)
+		return
+	}
+	s.serveCollection(w, r, "users", "", parts)
+}
+
+//serveCollection Plain list, get, update and delete of stored objects
+func (s *Server) serveCollection(w http.ResponseWriter, r *http.Request, kind string, environment string, parts []string) {
+	if len(parts) == 0 && r.Method == http.MethodGet {
+		writePage(w, r, s.list(kind, environment, r))
+		return
+	}
+	if len(parts) != 1 {
+		writeError(w, http.StatusNotFound, "Unknown endpoint "+r.URL.Path)
+		return
+	}
+
+	existing := s.find(kind, environment, parts[0])
+	if existing == nil {
+		writeError(w, http.StatusNotFound, "Object "+parts[0]+" not found")
+		return
+	}
+	switch r.Method {
+	case http.MethodGet:
+		writeData(w, existing.data)
+	case http.MethodPut, http.MethodPatch:
+		body, ok := readObject(w, r)
+		if !ok {
+			return
+		}
+		merge(existing.data, body)
+		writeData(w, existing.data)
+	case http.MethodDelete:
+		s.remove(kind, parts[0])
+		w.WriteHeader(http.StatusOK)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+	}
+}
+
+//serveService Endpoints under /services/{serviceCode}/{environmentName}/{kind}
+func (s *Server) serveService(w http.ResponseWriter, r *http.Request, parts []string) {
+	if len(parts) < 3 || parts[0] != ServiceCode {
+		writeError(w, http.StatusNotFound, "Unknown service "+r.URL.Path)
+		return
+	}
+	environment, kind, parts := parts[1], parts[2], parts[3:]
+	if s.findEnvironment(environment) == nil {
+		writeError(w, http.StatusNotFound, "Environment "+environment+" not found")
+		return
+	}
+
+	switch {
+	case kind == "images":
+		//Image IDs contain a slash
+		if len(parts) > 1 {
+			parts = []string{strings.Join(parts, "/")}
+		}
+		s.serveCollection(w, r, "images", "", parts)
+	case kind == "instances":
+		s.serveInstances(w, r, environment)
+	case settingsKinds[kind]:
+		s.serveSettings(w, r, environment, kind, parts)
+	case taskKinds[kind]:
+		s.serveTaskObjects(w, r, environment, kind, parts)
+	case kind == "networkpolicyrules":
+		if len(parts) == 0 && r.Method == http.MethodPost {
+			body, ok := readObject(w, r)
+			if !ok {
+				return
+			}
+			writeData(w, s.create(kind, environment, body).data)
+			return
+		}
+		s.serveCollection(w, r, kind, environment, parts)
+	default:
+		writeError(w, http.StatusNotFound, "Unknown endpoint "+r.URL.Path)
+	}
+}
+
+//serveTaskObjects Objects whose creates and updates are processed asynchronously and answered with a task
+func (s *Server) serveTaskObjects(w http.ResponseWriter, r *http.Request, environment string, kind string, parts []string) {
+	if len(parts) == 0 && r.Method == http.MethodPost {
+		body, ok := readObject(w, r)
+		if !ok {
+			return
+		}
+		if siteId := r.URL.Query().Get("siteId"); siteId != "" {
+			body["siteId"] = siteId
+		}
+		created := s.create(kind, environment, body)
+		s.writeTask(w, created.data)
+		return
+	}
+	if len(parts) == 1 && r.Method != http.MethodGet && r.Method != http.MethodDelete {
+		existing := s.find(kind, environment, parts[0])
+		if existing == nil {
+			writeError(w, http.StatusNotFound, "Object "+parts[0]+" not found")
+			return
+		}
+		//Sites are changed through operations, everything else through a new definition
+		if operation := r.URL.Query().Get("operation"); operation != "" {
+			existing.data["status"] = strings.ToUpper(operation) + "D"
+		} else {
+			body, ok := readObject(w, r)
+			if !ok {
+				return
+			}
+			merge(existing.data, body)
+		}
+		existing.data["updatedAt"] = now()
+		s.writeTask(w, existing.data)
+		return
+	}
+	s.serveCollection(w, r, kind, environment, parts)
+}
+
+//serveSettings Settings of a site, addressed by the site ID
+func (s *Server) serveSettings(w http.ResponseWriter, r *http.Request, environment string, kind string, parts []string) {
+	if len(parts) != 1 || s.find("sites", environment, parts[0]) == nil {
+		writeError(w, http.StatusNotFound, "Site not found")
+		return
+	}
+	settings := s.find(kind, environment, parts[0])
+	if settings == nil {
+		settings = s.add(kind, environment, object{"id": parts[0], "stackId": s.stackId(environment)})
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeData(w, settings.data)
+	case http.MethodPatch:
+		body, ok := readObject(w, r)
+		if !ok {
+			return
+		}
+		merge(settings.data, body)
+		settings.data["id"] = parts[0]
+		if kind == "originsettings" {
+			writeData(w, settings.data)
+			return
+		}
+		s.writeTask(w, settings.data)
+	case http.MethodPut:
+		//CDN purges
+		s.writeTask(w, settings.data)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+	}
+}
+
+//serveInstances One running instance per workload
+func (s *Server) serveInstances(w http.ResponseWriter, r *http.Request, environment string) {
+	workloadId := r.URL.Query().Get("workloadId")
+	instances := []interface{}{}
+	for _, workload := range s.objects["workloads"] {
+		if workload.environment != environment || (workloadId != "" && workload.data["id"] != workloadId) {
+			continue
+		}
+		instances = append(instances, object{
+			"id":              fmt.Sprintf("%s-instance-0", workload.data["id"]),
+			"stackId":         workload.data["stackId"],
+			"workloadId":      workload.data["id"],
+			"name":            fmt.Sprintf("%s-0", workload.data["name"]),
+			"ipAddress":       "10.0.0.2",
+			"publicIpAddress": "203.0.113.10",
+			"location":        "MIA",
+			"status":          "RUNNING",
+			"createdDate":     workload.data["created"],
+			"startedDate":     workload.data["created"],
+		})
+	}
+	writeData(w, instances)
+}
+
+func (s *Server) serveTask(w http.ResponseWriter, r *http.Request, parts []string) {
+	if len(parts) != 1 || r.Method != http.MethodGet {
+		writeError(w, http.StatusNotFound, "Unknown endpoint "+r.URL.Path)
+		return
+	}
+	t, ok := s.tasks[parts[0]]
+	if !ok {
+		writeError(w, http.StatusNotFound, "Task not found")
+		return
+	}
+
+	status := object{"id": parts[0]}
+	switch {
+	case t.pendingPolls > 0:
+		t.pendingPolls--
+		status["status"] = "PENDING"
+	case t.failure != "":
+		status["status"] = "FAILURE"
+		status["failureReason"] = t.failure
+		status["errorCode"] = "TASK_FAILED"
+	default:
+		status["status"] = "SUCCESS"
+		status["result"] = object{"id": t.resultId, "name": t.resultName}
+	}
+	writeData(w, status)
+}
+
+//writeTask Answer with a new task that resolves to the given object
+func (s *Server) writeTask(w http.ResponseWriter, result object) {
+	taskId := s.newId()
+	name, _ := result["name"].(string)
+	s.tasks[taskId] = &task{
+		resultId:     fmt.Sprint(result["id"]),
+		resultName:   name,
+		pendingPolls: s.PendingPolls,
+		failure:      s.nextFailure,
+	}
+	s.nextFailure = ""
+	writeJSON(w, http.StatusOK, object{"taskId": taskId, "taskStatus": "PENDING"})
+}
+
+//create Store a new service object with the fields the API fills in
+func (s *Server) create(kind string, environment string, body object) *record {
+	body["id"] = s.newId()
+	body["stackId"] = s.stackId(environment)
+	body["createdAt"] = now()
+	switch kind {
+	case "workloads":
+		body["created"] = body["createdAt"]
+		body["status"] = "RUNNING"
+		body["version"] = "1"
+	case "sites":
+		body["status"] = "ACTIVE"
+		body["edgeAddress"] = fmt.Sprintf("%s.edge.example.net", body["id"])
+		body["anycastIp"] = "198.51.100.1"
+	case "scripts":
+		body["version"] = "1"
+	}
+	return s.add(kind, environment, body)
+}
+
+func (s *Server) add(kind string, environment string, data object) *record {
+	r := &record{environment: environment, data: data}
+	s.objects[kind] = append(s.objects[kind], r)
+	return r
+}
+
+func (s *Server) find(kind string, environment string, id string) *record {
+	for _, r := range s.objects[kind] {
+		if r.environment == environment && r.data["id"] == id {
+			return r
+		}
+	}
+	return nil
+}
+
+func (s *Server) findEnvironment(name string) *record {
+	for _, r := range s.objects["environments"] {
+		if r.data["name"] == name {
+			return r
+		}
+	}
+	return nil
+}
+
+//list Objects of a kind in the environment, filtered by the query parameter of that kind
+func (s *Server) list(kind string, environment string, r *http.Request) []interface{} {
+	filterKey := listFilters[kind]
+	filterValue := r.URL.Query().Get(filterKey)
+	items := []interface{}{}
+	for _, existing := range s.objects[kind] {
+		if existing.environment != environment {
+			continue
+		}
+		if filterValue != "" && existing.data[filterKey] != filterValue {
+			continue
+		}
+		items = append(items, existing.data)
+	}
+	return items
+}
+
+func (s *Server) remove(kind string, id string) {
+	records := s.objects[kind]
+	for i, r := range records {
+		if r.data["id"] == id {
+			s.objects[kind] = append(records[:i], records[i+1:]...)
+			return
+		}
+	}
+}
+
+//newId UUID formatted IDs, unique within the server
+func (s *Server) newId() string {
+	s.lastId++
+	return fmt.Sprintf("00000000-0000-4000-8000-%012d", s.lastId)
+}
+
+func (s *Server) stackId(environment string) string {
+	return "stack-" + environment
+}
+
+//merge Copy the fields of src into dst, merging nested objects
+func merge(dst object, src object) {
+	for key, value := range src {
+		nested, isObject := value.(map[string]interface{})
+		existing, hasObject := dst[key].(map[string]interface{})
+		if isObject && hasObject {
+			merge(existing, nested)
+			continue
+		}
+		dst[key] = value
+	}
+}
+
+func asList(value interface{}) []interface{} {
+	list, _ := value.([]interface{})
+	return list
+}
+
+func readObject(w http.ResponseWriter, r *http.Request) (object, bool) {
+	body := object{}
+	bytes, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return nil, false
+	}
+	if len(bytes) == 0 {
+		return body, true
+	}
+	if err := json.Unmarshal(bytes, &body); err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid JSON body: "+err.Error())
+		return nil, false
+	}
+	return body, true
+}
+
+func writeData(w http.ResponseWriter, data interface{}) {
+	writeJSON(w, http.StatusOK, object{"data": data})
+}
+
+//writePage Write the page of items asked for by the page and size query parameters, all of them when size is not set
+func writePage(w http.ResponseWriter, r *http.Request, items []interface{}) {
+	recordCount := len(items)
+	if size, err := strconv.Atoi(r.URL.Query().Get("size")); err == nil && size > 0 {
+		page, err := strconv.Atoi(r.URL.Query().Get("page"))
+		if err != nil || page < 1 {
+			page = 1
+		}
+		start := (page - 1) * size
+		if start > len(items) {
+			start = len(items)
+		}
+		end := start + size
+		if end > len(items) {
+			end = len(items)
+		}
+		items = items[start:end]
+	}
+	writeJSON(w, http.StatusOK, object{"data": items, "metadata": object{"recordCount": recordCount}})
+}
+
+func writeError(w http.ResponseWriter, statusCode int, message string) {
+	writeJSON(w, statusCode, object{"errors": []interface{}{object{"code": statusCode, "message": message}}})
+}
+
+func writeJSON(w http.ResponseWriter, statusCode int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(body)
+}
+
+func now() string {
+	return time.Now().UTC().Format(time.RFC3339)
+}
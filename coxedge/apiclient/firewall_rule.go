@@ -6,118 +6,57 @@
 package apiclient
 
 import (
-	"bytes"
-	"encoding/json"
-	"net/http"
+	"context"
 )
 
-//GetFirewallRules Get FirewallRules in account
-func (c *Client) GetFirewallRules(environmentName string, siteId string, organizationId string) ([]FirewallRule, error) {
-	request, err := http.NewRequest("GET", CoxEdgeAPIBase+"/services/"+CoxEdgeServiceCode+"/"+environmentName+"/firewallrules?siteId="+siteId+"&org_id="+organizationId, nil)
-	if err != nil {
-		return nil, err
-	}
-
-	respBytes, err := c.doRequest(request)
-	if err != nil {
-		return nil, err
-	}
+//GetFirewallRules Get FirewallRules in account, reading every page
+func (c *Client) GetFirewallRules(ctx context.Context, environmentName string, siteId string, organizationId string) ([]FirewallRule, error) {
+	return c.FirewallRulesPages(ctx, environmentName, siteId, organizationId).all()
+}
 
-	var wrappedAPIStruct WrappedFirewallRules
-	err = json.Unmarshal(respBytes, &wrappedAPIStruct)
-	if err != nil {
-		return nil, err
-	}
-	return wrappedAPIStruct.Data, nil
+//FirewallRulesPages Iterate FirewallRules in account one page at a time
+func (c *Client) FirewallRulesPages(ctx context.Context, environmentName string, siteId string, organizationId string) *PageIterator[FirewallRule] {
+	return newPageIterator[FirewallRule](ctx, c, c.serviceURL(environmentName, "firewallrules").withId("siteId", siteId).withOrg(organizationId))
 }
 
 //GetFirewallRule Get FirewallRule in account by id
-func (c *Client) GetFirewallRule(environmentName string, siteId string, id string, organizationId string) (*FirewallRule, error) {
-	//Create the request
-	request, err := http.NewRequest("GET", CoxEdgeAPIBase+"/services/"+CoxEdgeServiceCode+"/"+environmentName+"/firewallrules/"+id+"?siteId="+siteId+"&org_id="+organizationId, nil)
-	if err != nil {
-		return nil, err
-	}
-
-	//Execute request
-	respBytes, err := c.doRequest(request)
-	if err != nil {
-		return nil, err
-	}
-
-	//Unmarshal, unwrap, and return
-	var wrappedAPIStruct WrappedFirewallRule
-	err = json.Unmarshal(respBytes, &wrappedAPIStruct)
-	if err != nil {
-		return nil, err
-	}
-	return &wrappedAPIStruct.Data, nil
+func (c *Client) GetFirewallRule(ctx context.Context, environmentName string, siteId string, id string, organizationId string) (*FirewallRule, error) {
+	firewallRule, ok, err := cachedGet(c, firewallRulesKey(environmentName, siteId, organizationId), id,
+		func() ([]FirewallRule, error) {
+			return c.GetFirewallRules(ctx, environmentName, siteId, organizationId)
+		},
+		func(firewallRule FirewallRule) string { return firewallRule.Id },
+	)
+	if ok || err != nil {
+		return firewallRule, err
+	}
+	return get[FirewallRule](ctx, c, c.serviceURL(environmentName, "firewallrules", id).withId("siteId", siteId).withOrg(organizationId))
 }
 
 //CreateFirewallRule Create the FirewallRule
-func (c *Client) CreateFirewallRule(environmentName string, newFirewallRule FirewallRule, organizationId string) (*TaskStatusResponse, error) {
-	//Marshal the request
-	jsonBytes, err := json.Marshal(newFirewallRule)
-	if err != nil {
-		return nil, err
-	}
-	//Wrap bytes in reader
-	bReader := bytes.NewReader(jsonBytes)
-	//Create the request
-	request, err := http.NewRequest("POST", CoxEdgeAPIBase+"/services/"+CoxEdgeServiceCode+"/"+environmentName+"/firewallrules?siteId="+newFirewallRule.SiteId+"&org_id="+organizationId, bReader)
-	request.Header.Set("Content-Type", "application/json")
-	//Execute request
-	respBytes, err := c.doRequest(request)
-	if err != nil {
-		return nil, err
-	}
-	//Return struct
-	var wrappedAPIStruct TaskStatusResponse
-	err = json.Unmarshal(respBytes, &wrappedAPIStruct)
-	if err != nil {
-		return nil, err
-	}
-	return &wrappedAPIStruct, nil
+func (c *Client) CreateFirewallRule(ctx context.Context, environmentName string, newFirewallRule FirewallRule, organizationId string) (*TaskStatusResponse, error) {
+	c.listCache.invalidate(firewallRulesKey(environmentName, newFirewallRule.SiteId, organizationId), "")
+	return createTask(ctx, c,
+		c.serviceURL(environmentName, "firewallrules").withId("siteId", newFirewallRule.SiteId).withOrg(organizationId),
+		newFirewallRule,
+	)
 }
 
 //UpdateFirewallRule Update a FirewallRule
-func (c *Client) UpdateFirewallRule(environmentName string, firewallRuleId string, newFirewallRule FirewallRule, organizationId string) (*TaskStatusResponse, error) {
-	//Marshal the request
-	jsonBytes, err := json.Marshal(newFirewallRule)
-	if err != nil {
-		return nil, err
-	}
-	//Wrap bytes in reader
-	bReader := bytes.NewReader(jsonBytes)
-	//Create the request
-	request, err := http.NewRequest("PUT", CoxEdgeAPIBase+"/services/"+CoxEdgeServiceCode+"/"+environmentName+"/firewallrules/"+firewallRuleId+"?siteId="+newFirewallRule.SiteId+"&org_id="+organizationId, bReader)
-	request.Header.Set("Content-Type", "application/json")
-	//Execute request
-	respBytes, err := c.doRequest(request)
-	if err != nil {
-		return nil, err
-	}
-	//Return struct
-	var wrappedAPIStruct TaskStatusResponse
-	err = json.Unmarshal(respBytes, &wrappedAPIStruct)
-	if err != nil {
-		return nil, err
-	}
-	return &wrappedAPIStruct, nil
+func (c *Client) UpdateFirewallRule(ctx context.Context, environmentName string, firewallRuleId string, newFirewallRule FirewallRule, organizationId string) (*TaskStatusResponse, error) {
+	c.listCache.invalidate(firewallRulesKey(environmentName, newFirewallRule.SiteId, organizationId), firewallRuleId)
+	return updateTask(ctx, c, "PUT",
+		c.serviceURL(environmentName, "firewallrules", firewallRuleId).withId("siteId", newFirewallRule.SiteId).withOrg(organizationId),
+		newFirewallRule,
+	)
 }
 
 //DeleteFirewallRule Delete FirewallRule in account by id
-func (c *Client) DeleteFirewallRule(environmentName string, siteId string, id string, organizationId string) error {
-	//Create the request
-	request, err := http.NewRequest("DELETE", CoxEdgeAPIBase+"/services/"+CoxEdgeServiceCode+"/"+environmentName+"/firewallrules/"+id+"?siteId="+siteId+"&org_id="+organizationId, nil)
-	if err != nil {
-		return err
-	}
+func (c *Client) DeleteFirewallRule(ctx context.Context, environmentName string, siteId string, id string, organizationId string) error {
+	c.listCache.invalidate(firewallRulesKey(environmentName, siteId, organizationId), id)
+	return remove(ctx, c, c.serviceURL(environmentName, "firewallrules", id).withId("siteId", siteId).withOrg(organizationId))
+}
 
-	//Execute request
-	_, err = c.doRequest(request)
-	if err != nil {
-		return err
-	}
-	return nil
+func firewallRulesKey(environmentName string, siteId string, organizationId string) listCacheKey {
+	return listCacheKey{kind: "firewallrules", environmentName: environmentName, siteId: siteId, organizationId: organizationId}
 }
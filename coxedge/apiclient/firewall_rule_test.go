@@ -0,0 +1,53 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+package apiclient
+
+import (
+	"context"
+	"coxedge/terraform-provider/coxedge/apiclient/fake"
+	"testing"
+)
+
+func TestFirewallRuleLifecycle(t *testing.T) {
+	siteId := createTestSite(t, "firewall.cc.com")
+	newRule := FirewallRule{
+		Action:  "ALLOW",
+		Enabled: true,
+		IpStart: "192.0.2.1",
+		IpEnd:   "192.0.2.10",
+		Name:    "office",
+		SiteId:  siteId,
+	}
+	taskResp, err := apiClient.CreateFirewallRule(context.TODO(), fake.EnvironmentName, newRule, fakeServer.OrganizationId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ruleId := awaitTask(t, taskResp.TaskId).Data.Result.Id
+
+	newRule.Action = "BLOCK"
+	taskResp, err = apiClient.UpdateFirewallRule(context.TODO(), fake.EnvironmentName, ruleId, newRule, fakeServer.OrganizationId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	awaitTask(t, taskResp.TaskId)
+
+	rules, err := apiClient.GetFirewallRules(context.TODO(), fake.EnvironmentName, siteId, fakeServer.OrganizationId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rules) != 1 || rules[0].Action != "BLOCK" {
+		t.Errorf("unexpected rules %+v", rules)
+	}
+
+	err = apiClient.DeleteFirewallRule(context.TODO(), fake.EnvironmentName, siteId, ruleId, fakeServer.OrganizationId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = apiClient.GetFirewallRule(context.TODO(), fake.EnvironmentName, siteId, ruleId, fakeServer.OrganizationId)
+	if !IsNotFound(err) {
+		t.Errorf("expected the rule to be gone, got %v", err)
+	}
+}
@@ -6,49 +6,22 @@
 package apiclient
 
 import (
-	"encoding/json"
-	"fmt"
-	"net/http"
+	"context"
+	"strings"
 )
 
-//GetImages Get images in account
-func (c *Client) GetImages(environmentName string) ([]Image, error) {
-	request, err := http.NewRequest("GET", CoxEdgeAPIBase+"/services/"+CoxEdgeServiceCode+"/"+environmentName+"/images", nil)
-	if err != nil {
-		return nil, err
-	}
-
-	respBytes, err := c.doRequest(request)
-	if err != nil {
-		return nil, err
-	}
+//GetImages Get images in account, reading every page
+func (c *Client) GetImages(ctx context.Context, environmentName string) ([]Image, error) {
+	return c.ImagesPages(ctx, environmentName).all()
+}
 
-	var wrappedAPIStruct WrappedImages
-	err = json.Unmarshal(respBytes, &wrappedAPIStruct)
-	if err != nil {
-		return nil, err
-	}
-	return wrappedAPIStruct.Data, nil
+//ImagesPages Iterate images in account one page at a time
+func (c *Client) ImagesPages(ctx context.Context, environmentName string) *PageIterator[Image] {
+	return newPageIterator[Image](ctx, c, c.serviceURL(environmentName, "images"))
 }
 
 //GetImage Get images in account by id
-func (c *Client) GetImage(environmentName string, id string) (*Image, error) {
-	request, err := http.NewRequest("GET", CoxEdgeAPIBase+"/services/"+CoxEdgeServiceCode+"/"+environmentName+"/images/"+id, nil)
-	if err != nil {
-		return nil, err
-	}
-
-	respBytes, err := c.doRequest(request)
-
-	fmt.Println(string(respBytes))
-	if err != nil {
-		return nil, err
-	}
-
-	var wrappedAPIStruct WrappedImage
-	err = json.Unmarshal(respBytes, &wrappedAPIStruct)
-	if err != nil {
-		return nil, err
-	}
-	return &wrappedAPIStruct.Data, nil
+func (c *Client) GetImage(ctx context.Context, environmentName string, id string) (*Image, error) {
+	//Image IDs such as "stackpath-edge/centos-7:v201905241424" keep their slash in the path
+	return get[Image](ctx, c, c.serviceURL(environmentName, append([]string{"images"}, strings.Split(id, "/")...)...))
 }
@@ -0,0 +1,30 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+package apiclient
+
+import (
+	"context"
+	"coxedge/terraform-provider/coxedge/apiclient/fake"
+	"testing"
+)
+
+func TestGetImages(t *testing.T) {
+	images, err := apiClient.GetImages(context.TODO(), fake.EnvironmentName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(images) == 0 {
+		t.Fatal("Got 0 images")
+	}
+
+	image, err := apiClient.GetImage(context.TODO(), fake.EnvironmentName, images[0].Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if image.Family != images[0].Family {
+		t.Errorf("unexpected image %+v", image)
+	}
+}
@@ -0,0 +1,121 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+package apiclient
+
+import (
+	"encoding/json"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+//maxLoggedBodyBytes Request and response bodies are cut to this length in the logs
+const maxLoggedBodyBytes = 4096
+
+const redacted = "REDACTED"
+
+//sensitiveHeaders Headers never written to the logs
+var sensitiveHeaders = map[string]bool{
+	"Mc-Api-Key":    true,
+	"Authorization": true,
+}
+
+//sensitiveFields JSON fields never written to the logs, at any depth of the body
+var sensitiveFields = map[string]bool{
+	"password":                   true,
+	"containerPassword":          true,
+	"secretEnvironmentVariables": true,
+}
+
+//logRequest Log the request about to be sent, with secrets redacted
+func logRequest(req *http.Request) {
+	fields := []interface{}{
+		"method", req.Method,
+		"url", req.URL.String(),
+		"headers", redactHeaders(req.Header),
+	}
+	if req.GetBody != nil {
+		if body, err := req.GetBody(); err == nil {
+			bodyBytes, _ := ioutil.ReadAll(body)
+			body.Close()
+			fields = append(fields, "body", redactBody(bodyBytes))
+		}
+	}
+	tflog.Debug(req.Context(), "Sending Cox Edge API request", fields...)
+}
+
+//logResponse Log the response to the request, or the error that prevented it, with secrets redacted
+func logResponse(req *http.Request, res *http.Response, body []byte, latency time.Duration, err error) {
+	fields := []interface{}{
+		"method", req.Method,
+		"url", req.URL.String(),
+		"latency", latency.String(),
+	}
+	if res != nil {
+		fields = append(fields, "status", res.StatusCode, "body", redactBody(body))
+		if requestId := res.Header.Get("X-Request-Id"); requestId != "" {
+			fields = append(fields, "request_id", requestId)
+		}
+	}
+	if err != nil {
+		fields = append(fields, "error", err.Error())
+	}
+	tflog.Debug(req.Context(), "Received Cox Edge API response", fields...)
+}
+
+//redactHeaders Flatten the headers for logging, hiding the API key
+func redactHeaders(header http.Header) map[string]string {
+	flattened := make(map[string]string, len(header))
+	for name, values := range header {
+		value := ""
+		if len(values) > 0 {
+			value = values[0]
+		}
+		if sensitiveHeaders[http.CanonicalHeaderKey(name)] {
+			value = redacted
+		}
+		flattened[name] = value
+	}
+	return flattened
+}
+
+//redactBody The body as it may be logged: sensitive JSON fields replaced and the result truncated. Bodies that are
+//not JSON are truncated only.
+func redactBody(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+	var decoded interface{}
+	if json.Unmarshal(body, &decoded) == nil {
+		if redactedBody, err := json.Marshal(redactValue(decoded)); err == nil {
+			body = redactedBody
+		}
+	}
+	if len(body) > maxLoggedBodyBytes {
+		return string(body[:maxLoggedBodyBytes]) + "... (truncated, " + strconv.Itoa(len(body)) + " bytes)"
+	}
+	return string(body)
+}
+
+func redactValue(value interface{}) interface{} {
+	switch typed := value.(type) {
+	case map[string]interface{}:
+		for key, fieldValue := range typed {
+			if sensitiveFields[key] {
+				typed[key] = redacted
+			} else {
+				typed[key] = redactValue(fieldValue)
+			}
+		}
+	case []interface{}:
+		for i, item := range typed {
+			typed[i] = redactValue(item)
+		}
+	}
+	return value
+}
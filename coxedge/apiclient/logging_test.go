@@ -0,0 +1,80 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+package apiclient
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestRedactBody(t *testing.T) {
+	testCases := []struct {
+		name   string
+		body   string
+		expect string
+	}{
+		{
+			name:   "user password",
+			body:   `{"userName":"jdoe","password":"hunter2"}`,
+			expect: `{"password":"REDACTED","userName":"jdoe"}`,
+		},
+		{
+			name:   "workload secrets",
+			body:   `{"name":"web","containerPassword":"hunter2","secretEnvironmentVariables":[{"key":"TOKEN","value":"abc"}]}`,
+			expect: `{"containerPassword":"REDACTED","name":"web","secretEnvironmentVariables":"REDACTED"}`,
+		},
+		{
+			name:   "nested origin password",
+			body:   `{"data":{"origin":{"address":"origin.example.com","username":"admin","password":"hunter2"}}}`,
+			expect: `{"data":{"origin":{"address":"origin.example.com","password":"REDACTED","username":"admin"}}}`,
+		},
+		{
+			name:   "passwords in lists",
+			body:   `[{"password":"a"},{"password":"b"}]`,
+			expect: `[{"password":"REDACTED"},{"password":"REDACTED"}]`,
+		},
+		{
+			name:   "not JSON",
+			body:   `<html>Bad Gateway</html>`,
+			expect: `<html>Bad Gateway</html>`,
+		},
+		{
+			name:   "empty",
+			body:   ``,
+			expect: ``,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := redactBody([]byte(tc.body)); got != tc.expect {
+				t.Errorf("expected %s, got %s", tc.expect, got)
+			}
+		})
+	}
+}
+
+func TestRedactBodyTruncates(t *testing.T) {
+	got := redactBody([]byte(strings.Repeat("a", maxLoggedBodyBytes+10)))
+	if !strings.HasPrefix(got, strings.Repeat("a", maxLoggedBodyBytes)+"... (truncated") {
+		t.Errorf("expected the body to be truncated, got %d bytes", len(got))
+	}
+}
+
+func TestRedactHeaders(t *testing.T) {
+	header := http.Header{}
+	header.Set("MC-Api-Key", "secret-key")
+	header.Set("Content-Type", "application/json")
+
+	got := redactHeaders(header)
+	if got["Mc-Api-Key"] != redacted {
+		t.Errorf("expected the API key to be redacted, got %q", got["Mc-Api-Key"])
+	}
+	if got["Content-Type"] != "application/json" {
+		t.Errorf("expected other headers to be kept, got %q", got["Content-Type"])
+	}
+}
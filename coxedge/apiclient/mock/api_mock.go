@@ -0,0 +1,3973 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package mock
+
+import (
+	"context"
+	"coxedge/terraform-provider/coxedge/apiclient"
+	"sync"
+	"time"
+)
+
+// Ensure, that APIMock does implement apiclient.API.
+// If this is not the case, regenerate this file with moq.
+var _ apiclient.API = &APIMock{}
+
+// APIMock is a mock implementation of apiclient.API.
+//
+//	func TestSomethingThatUsesAPI(t *testing.T) {
+//
+//		// make and configure a mocked apiclient.API
+//		mockedAPI := &APIMock{
+//			AwaitTaskResolveFunc: func(ctx context.Context, taskId string, minInterval time.Duration, maxInterval time.Duration, timeout time.Duration) (*apiclient.TaskStatus, error) {
+//				panic("mock out the AwaitTaskResolve method")
+//			},
+//			AwaitTaskResolveWithDefaultsFunc: func(ctx context.Context, taskId string) (*apiclient.TaskStatus, error) {
+//				panic("mock out the AwaitTaskResolveWithDefaults method")
+//			},
+//			AwaitTaskResolveWithTimeoutFunc: func(ctx context.Context, taskId string, timeout time.Duration) (*apiclient.TaskStatus, error) {
+//				panic("mock out the AwaitTaskResolveWithTimeout method")
+//			},
+//			CreateDeliveryDomainFunc: func(ctx context.Context, siteId string, newDeliveryDomain apiclient.DeliveryDomainCreateRequest, organizationId string) (*apiclient.TaskStatusResponse, error) {
+//				panic("mock out the CreateDeliveryDomain method")
+//			},
+//			CreateEnvironmentFunc: func(ctx context.Context, newEnvironment apiclient.EnvironmentCreateRequest) (*apiclient.Environment, error) {
+//				panic("mock out the CreateEnvironment method")
+//			},
+//			CreateFirewallRuleFunc: func(ctx context.Context, environmentName string, newFirewallRule apiclient.FirewallRule, organizationId string) (*apiclient.TaskStatusResponse, error) {
+//				panic("mock out the CreateFirewallRule method")
+//			},
+//			CreateNetworkPolicyRuleFunc: func(ctx context.Context, newNetworkPolicyRule apiclient.NetworkPolicyRuleCreateRequest, organizationId string) ([]apiclient.NetworkPolicyRule, error) {
+//				panic("mock out the CreateNetworkPolicyRule method")
+//			},
+//			CreateOriginSettingsFunc: func(ctx context.Context, newOriginSettings apiclient.OriginSettings) (*apiclient.OriginSettings, error) {
+//				panic("mock out the CreateOriginSettings method")
+//			},
+//			CreateRoleFunc: func(ctx context.Context, newRole apiclient.RoleCreateRequest) (*apiclient.Roles, error) {
+//				panic("mock out the CreateRole method")
+//			},
+//			CreateScriptFunc: func(ctx context.Context, siteId string, environmentName string, newScript apiclient.ScriptCreateRequest, organizationId string) (*apiclient.TaskStatusResponse, error) {
+//				panic("mock out the CreateScript method")
+//			},
+//			CreateSiteFunc: func(ctx context.Context, newSite apiclient.SiteCreateRequest, organizationId string) (*apiclient.TaskStatusResponse, error) {
+//				panic("mock out the CreateSite method")
+//			},
+//			CreateUserFunc: func(ctx context.Context, newUser apiclient.UserCreateRequest) (*apiclient.User, error) {
+//				panic("mock out the CreateUser method")
+//			},
+//			CreateWorkloadFunc: func(ctx context.Context, newWorkload apiclient.WorkloadCreateRequest, organizationId string) (*apiclient.TaskStatusResponse, error) {
+//				panic("mock out the CreateWorkload method")
+//			},
+//			DeleteDeliveryDomainFunc: func(ctx context.Context, environmentName string, id string, organizationId string) error {
+//				panic("mock out the DeleteDeliveryDomain method")
+//			},
+//			DeleteEnvironmentFunc: func(ctx context.Context, id string) error {
+//				panic("mock out the DeleteEnvironment method")
+//			},
+//			DeleteFirewallRuleFunc: func(ctx context.Context, environmentName string, siteId string, id string, organizationId string) error {
+//				panic("mock out the DeleteFirewallRule method")
+//			},
+//			DeleteNetworkPolicyRuleFunc: func(ctx context.Context, environmentName string, id string, organizationId string, newNetworkPolicyRule apiclient.NetworkPolicyRuleCreateRequest) error {
+//				panic("mock out the DeleteNetworkPolicyRule method")
+//			},
+//			DeleteRoleFunc: func(ctx context.Context, id string) error {
+//				panic("mock out the DeleteRole method")
+//			},
+//			DeleteScriptFunc: func(ctx context.Context, id string, siteId string, environmentName string, organizationId string) error {
+//				panic("mock out the DeleteScript method")
+//			},
+//			DeleteSiteFunc: func(ctx context.Context, environmentName string, id string, organizationId string) error {
+//				panic("mock out the DeleteSite method")
+//			},
+//			DeleteUserFunc: func(ctx context.Context, id string) error {
+//				panic("mock out the DeleteUser method")
+//			},
+//			DeleteWorkloadFunc: func(ctx context.Context, environmentName string, id string, organizationId string) error {
+//				panic("mock out the DeleteWorkload method")
+//			},
+//			GetCDNSettingsFunc: func(ctx context.Context, environmentName string, id string, organizationId string) (*apiclient.CDNSettings, error) {
+//				panic("mock out the GetCDNSettings method")
+//			},
+//			GetDeliveryDomainFunc: func(ctx context.Context, environmentName string, id string, organizationId string) (*apiclient.DeliveryDomain, error) {
+//				panic("mock out the GetDeliveryDomain method")
+//			},
+//			GetDeliveryDomainsFunc: func(ctx context.Context, environmentName string, organizationId string) ([]apiclient.DeliveryDomain, error) {
+//				panic("mock out the GetDeliveryDomains method")
+//			},
+//			GetEdgeLogicFunc: func(ctx context.Context, environmentName string, id string, organizationId string) (*apiclient.EdgeLogic, error) {
+//				panic("mock out the GetEdgeLogic method")
+//			},
+//			GetEnvironmentFunc: func(ctx context.Context, id string) (*apiclient.Environment, error) {
+//				panic("mock out the GetEnvironment method")
+//			},
+//			GetEnvironmentsFunc: func(ctx context.Context) ([]apiclient.Environment, error) {
+//				panic("mock out the GetEnvironments method")
+//			},
+//			GetFirewallRuleFunc: func(ctx context.Context, environmentName string, siteId string, id string, organizationId string) (*apiclient.FirewallRule, error) {
+//				panic("mock out the GetFirewallRule method")
+//			},
+//			GetFirewallRulesFunc: func(ctx context.Context, environmentName string, siteId string, organizationId string) ([]apiclient.FirewallRule, error) {
+//				panic("mock out the GetFirewallRules method")
+//			},
+//			GetImageFunc: func(ctx context.Context, environmentName string, id string) (*apiclient.Image, error) {
+//				panic("mock out the GetImage method")
+//			},
+//			GetImagesFunc: func(ctx context.Context, environmentName string) ([]apiclient.Image, error) {
+//				panic("mock out the GetImages method")
+//			},
+//			GetNetworkPolicyRuleFunc: func(ctx context.Context, environmentName string, id string, organizationId string) (*apiclient.NetworkPolicyRule, error) {
+//				panic("mock out the GetNetworkPolicyRule method")
+//			},
+//			GetNetworkPolicyRuleWorkloadFunc: func(ctx context.Context, environmentName string, id string, organizationId string) ([]apiclient.NetworkPolicyRule, error) {
+//				panic("mock out the GetNetworkPolicyRuleWorkload method")
+//			},
+//			GetNetworkPolicyRulesFunc: func(ctx context.Context, environmentName string, organizationId string) ([]apiclient.NetworkPolicyRule, error) {
+//				panic("mock out the GetNetworkPolicyRules method")
+//			},
+//			GetOrganizationFunc: func(ctx context.Context, id string) (*apiclient.Organization, error) {
+//				panic("mock out the GetOrganization method")
+//			},
+//			GetOrganizationBillingInfoFunc: func(ctx context.Context, id string) (*apiclient.OrganizationBillingInfo, error) {
+//				panic("mock out the GetOrganizationBillingInfo method")
+//			},
+//			GetOrganizationsFunc: func(ctx context.Context) ([]apiclient.Organization, error) {
+//				panic("mock out the GetOrganizations method")
+//			},
+//			GetOriginSettingsFunc: func(ctx context.Context, environmentName string, id string, organizationId string) (*apiclient.OriginSettings, error) {
+//				panic("mock out the GetOriginSettings method")
+//			},
+//			GetRoleFunc: func(ctx context.Context, id string) (*apiclient.Roles, error) {
+//				panic("mock out the GetRole method")
+//			},
+//			GetRolesFunc: func(ctx context.Context) ([]apiclient.Roles, error) {
+//				panic("mock out the GetRoles method")
+//			},
+//			GetScriptFunc: func(ctx context.Context, id string, siteId string, environmentName string, organizationId string) (*apiclient.Script, error) {
+//				panic("mock out the GetScript method")
+//			},
+//			GetScriptsFunc: func(ctx context.Context, siteId string, environmentName string, organizationId string) ([]apiclient.Script, error) {
+//				panic("mock out the GetScripts method")
+//			},
+//			GetSiteFunc: func(ctx context.Context, environmentName string, id string, organizationId string) (*apiclient.Site, error) {
+//				panic("mock out the GetSite method")
+//			},
+//			GetSitesFunc: func(ctx context.Context, environmentName string, organizationId string) ([]apiclient.Site, error) {
+//				panic("mock out the GetSites method")
+//			},
+//			GetTaskStatusFunc: func(ctx context.Context, taskId string) (*apiclient.TaskStatus, error) {
+//				panic("mock out the GetTaskStatus method")
+//			},
+//			GetUserFunc: func(ctx context.Context, id string) (*apiclient.User, error) {
+//				panic("mock out the GetUser method")
+//			},
+//			GetUsersFunc: func(ctx context.Context) ([]apiclient.User, error) {
+//				panic("mock out the GetUsers method")
+//			},
+//			GetWAFSettingsFunc: func(ctx context.Context, environmentName string, id string, organizationId string) (*apiclient.WAFSettings, error) {
+//				panic("mock out the GetWAFSettings method")
+//			},
+//			GetWorkloadFunc: func(ctx context.Context, environmentName string, id string, organizationId string) (*apiclient.Workload, error) {
+//				panic("mock out the GetWorkload method")
+//			},
+//			GetWorkloadInstancesFunc: func(ctx context.Context, environmentName string, organizationId string, workloadId string) ([]apiclient.WorkloadInstance, error) {
+//				panic("mock out the GetWorkloadInstances method")
+//			},
+//			GetWorkloadsFunc: func(ctx context.Context, environmentName string, organizationId string) ([]apiclient.Workload, error) {
+//				panic("mock out the GetWorkloads method")
+//			},
+//			PurgeCDNFunc: func(ctx context.Context, environmentName string, siteId string, options apiclient.CDNPurgeOptions, organizationId string) (*apiclient.TaskStatusResponse, error) {
+//				panic("mock out the PurgeCDN method")
+//			},
+//			UnlockUserFunc: func(ctx context.Context, id string) error {
+//				panic("mock out the UnlockUser method")
+//			},
+//			UpdateCDNSettingsFunc: func(ctx context.Context, cdnSettingsId string, newCDNSettings apiclient.CDNSettings, organizationId string) (*apiclient.TaskStatusResponse, error) {
+//				panic("mock out the UpdateCDNSettings method")
+//			},
+//			UpdateEdgeLogicFunc: func(ctx context.Context, edgeLogicId string, newEdgeLogic apiclient.EdgeLogic, organizationId string) (*apiclient.TaskStatusResponse, error) {
+//				panic("mock out the UpdateEdgeLogic method")
+//			},
+//			UpdateEnvironmentFunc: func(ctx context.Context, EnvironmentId string, newEnvironment apiclient.EnvironmentCreateRequest) (*apiclient.Environment, error) {
+//				panic("mock out the UpdateEnvironment method")
+//			},
+//			UpdateEnvironmentMemberFunc: func(ctx context.Context, EnvironmentId string, newEnvironment apiclient.EnvironmentMembersRequest) (*apiclient.Environment, error) {
+//				panic("mock out the UpdateEnvironmentMember method")
+//			},
+//			UpdateEnvironmentMembershipFunc: func(ctx context.Context, EnvironmentId string, newEnvironment apiclient.EnvironmentMembershipRequest) (*apiclient.Environment, error) {
+//				panic("mock out the UpdateEnvironmentMembership method")
+//			},
+//			UpdateFirewallRuleFunc: func(ctx context.Context, environmentName string, firewallRuleId string, newFirewallRule apiclient.FirewallRule, organizationId string) (*apiclient.TaskStatusResponse, error) {
+//				panic("mock out the UpdateFirewallRule method")
+//			},
+//			UpdateNetworkPolicyRuleFunc: func(ctx context.Context, networkPolicyRuleId string, newNetworkPolicyRule apiclient.NetworkPolicyRuleCreateRequest, organizationId string) ([]apiclient.NetworkPolicyRule, error) {
+//				panic("mock out the UpdateNetworkPolicyRule method")
+//			},
+//			UpdateOriginSettingsFunc: func(ctx context.Context, originSettingsId string, newOriginSettings apiclient.OriginSettings, organizationId string) (*apiclient.OriginSettings, error) {
+//				panic("mock out the UpdateOriginSettings method")
+//			},
+//			UpdateRoleFunc: func(ctx context.Context, roleId string, newRole apiclient.RoleCreateRequest) (*apiclient.Roles, error) {
+//				panic("mock out the UpdateRole method")
+//			},
+//			UpdateScriptFunc: func(ctx context.Context, id string, siteId string, environmentName string, newScript apiclient.ScriptCreateRequest, organizationId string) (*apiclient.TaskStatusResponse, error) {
+//				panic("mock out the UpdateScript method")
+//			},
+//			UpdateSiteFunc: func(ctx context.Context, siteId string, environmentName string, operationValue string, organizationId string) (*apiclient.TaskStatusResponse, error) {
+//				panic("mock out the UpdateSite method")
+//			},
+//			UpdateUserFunc: func(ctx context.Context, userId string, newUser apiclient.UserCreateRequest) (*apiclient.User, error) {
+//				panic("mock out the UpdateUser method")
+//			},
+//			UpdateWAFSettingsFunc: func(ctx context.Context, wafSettingsId string, newWAFSettings apiclient.WAFSettings, organizationId string) (*apiclient.TaskStatusResponse, error) {
+//				panic("mock out the UpdateWAFSettings method")
+//			},
+//			UpdateWorkloadFunc: func(ctx context.Context, workloadId string, newWorkload apiclient.WorkloadCreateRequest, organizationId string) (*apiclient.TaskStatusResponse, error) {
+//				panic("mock out the UpdateWorkload method")
+//			},
+//		}
+//
+//		// use mockedAPI in code that requires apiclient.API
+//		// and then make assertions.
+//
+//	}
+type APIMock struct {
+	// AwaitTaskResolveFunc mocks the AwaitTaskResolve method.
+	AwaitTaskResolveFunc func(ctx context.Context, taskId string, minInterval time.Duration, maxInterval time.Duration, timeout time.Duration) (*apiclient.TaskStatus, error)
+
+	// AwaitTaskResolveWithDefaultsFunc mocks the AwaitTaskResolveWithDefaults method.
+	AwaitTaskResolveWithDefaultsFunc func(ctx context.Context, taskId string) (*apiclient.TaskStatus, error)
+
+	// AwaitTaskResolveWithTimeoutFunc mocks the AwaitTaskResolveWithTimeout method.
+	AwaitTaskResolveWithTimeoutFunc func(ctx context.Context, taskId string, timeout time.Duration) (*apiclient.TaskStatus, error)
+
+	// CreateDeliveryDomainFunc mocks the CreateDeliveryDomain method.
+	CreateDeliveryDomainFunc func(ctx context.Context, siteId string, newDeliveryDomain apiclient.DeliveryDomainCreateRequest, organizationId string) (*apiclient.TaskStatusResponse, error)
+
+	// CreateEnvironmentFunc mocks the CreateEnvironment method.
+	CreateEnvironmentFunc func(ctx context.Context, newEnvironment apiclient.EnvironmentCreateRequest) (*apiclient.Environment, error)
+
+	// CreateFirewallRuleFunc mocks the CreateFirewallRule method.
+	CreateFirewallRuleFunc func(ctx context.Context, environmentName string, newFirewallRule apiclient.FirewallRule, organizationId string) (*apiclient.TaskStatusResponse, error)
+
+	// CreateNetworkPolicyRuleFunc mocks the CreateNetworkPolicyRule method.
+	CreateNetworkPolicyRuleFunc func(ctx context.Context, newNetworkPolicyRule apiclient.NetworkPolicyRuleCreateRequest, organizationId string) ([]apiclient.NetworkPolicyRule, error)
+
+	// CreateOriginSettingsFunc mocks the CreateOriginSettings method.
+	CreateOriginSettingsFunc func(ctx context.Context, newOriginSettings apiclient.OriginSettings) (*apiclient.OriginSettings, error)
+
+	// CreateRoleFunc mocks the CreateRole method.
+	CreateRoleFunc func(ctx context.Context, newRole apiclient.RoleCreateRequest) (*apiclient.Roles, error)
+
+	// CreateScriptFunc mocks the CreateScript method.
+	CreateScriptFunc func(ctx context.Context, siteId string, environmentName string, newScript apiclient.ScriptCreateRequest, organizationId string) (*apiclient.TaskStatusResponse, error)
+
+	// CreateSiteFunc mocks the CreateSite method.
+	CreateSiteFunc func(ctx context.Context, newSite apiclient.SiteCreateRequest, organizationId string) (*apiclient.TaskStatusResponse, error)
+
+	// CreateUserFunc mocks the CreateUser method.
+	CreateUserFunc func(ctx context.Context, newUser apiclient.UserCreateRequest) (*apiclient.User, error)
+
+	// CreateWorkloadFunc mocks the CreateWorkload method.
+	CreateWorkloadFunc func(ctx context.Context, newWorkload apiclient.WorkloadCreateRequest, organizationId string) (*apiclient.TaskStatusResponse, error)
+
+	// DeleteDeliveryDomainFunc mocks the DeleteDeliveryDomain method.
+	DeleteDeliveryDomainFunc func(ctx context.Context, environmentName string, id string, organizationId string) error
+
+	// DeleteEnvironmentFunc mocks the DeleteEnvironment method.
+	DeleteEnvironmentFunc func(ctx context.Context, id string) error
+
+	// DeleteFirewallRuleFunc mocks the DeleteFirewallRule method.
+	DeleteFirewallRuleFunc func(ctx context.Context, environmentName string, siteId string, id string, organizationId string) error
+
+	// DeleteNetworkPolicyRuleFunc mocks the DeleteNetworkPolicyRule method.
+	DeleteNetworkPolicyRuleFunc func(ctx context.Context, environmentName string, id string, organizationId string, newNetworkPolicyRule apiclient.NetworkPolicyRuleCreateRequest) error
+
+	// DeleteRoleFunc mocks the DeleteRole method.
+	DeleteRoleFunc func(ctx context.Context, id string) error
+
+	// DeleteScriptFunc mocks the DeleteScript method.
+	DeleteScriptFunc func(ctx context.Context, id string, siteId string, environmentName string, organizationId string) error
+
+	// DeleteSiteFunc mocks the DeleteSite method.
+	DeleteSiteFunc func(ctx context.Context, environmentName string, id string, organizationId string) error
+
+	// DeleteUserFunc mocks the DeleteUser method.
+	DeleteUserFunc func(ctx context.Context, id string) error
+
+	// DeleteWorkloadFunc mocks the DeleteWorkload method.
+	DeleteWorkloadFunc func(ctx context.Context, environmentName string, id string, organizationId string) error
+
+	// GetCDNSettingsFunc mocks the GetCDNSettings method.
+	GetCDNSettingsFunc func(ctx context.Context, environmentName string, id string, organizationId string) (*apiclient.CDNSettings, error)
+
+	// GetDeliveryDomainFunc mocks the GetDeliveryDomain method.
+	GetDeliveryDomainFunc func(ctx context.Context, environmentName string, id string, organizationId string) (*apiclient.DeliveryDomain, error)
+
+	// GetDeliveryDomainsFunc mocks the GetDeliveryDomains method.
+	GetDeliveryDomainsFunc func(ctx context.Context, environmentName string, organizationId string) ([]apiclient.DeliveryDomain, error)
+
+	// GetEdgeLogicFunc mocks the GetEdgeLogic method.
+	GetEdgeLogicFunc func(ctx context.Context, environmentName string, id string, organizationId string) (*apiclient.EdgeLogic, error)
+
+	// GetEnvironmentFunc mocks the GetEnvironment method.
+	GetEnvironmentFunc func(ctx context.Context, id string) (*apiclient.Environment, error)
+
+	// GetEnvironmentsFunc mocks the GetEnvironments method.
+	GetEnvironmentsFunc func(ctx context.Context) ([]apiclient.Environment, error)
+
+	// GetFirewallRuleFunc mocks the GetFirewallRule method.
+	GetFirewallRuleFunc func(ctx context.Context, environmentName string, siteId string, id string, organizationId string) (*apiclient.FirewallRule, error)
+
+	// GetFirewallRulesFunc mocks the GetFirewallRules method.
+	GetFirewallRulesFunc func(ctx context.Context, environmentName string, siteId string, organizationId string) ([]apiclient.FirewallRule, error)
+
+	// GetImageFunc mocks the GetImage method.
+	GetImageFunc func(ctx context.Context, environmentName string, id string) (*apiclient.Image, error)
+
+	// GetImagesFunc mocks the GetImages method.
+	GetImagesFunc func(ctx context.Context, environmentName string) ([]apiclient.Image, error)
+
+	// GetNetworkPolicyRuleFunc mocks the GetNetworkPolicyRule method.
+	GetNetworkPolicyRuleFunc func(ctx context.Context, environmentName string, id string, organizationId string) (*apiclient.NetworkPolicyRule, error)
+
+	// GetNetworkPolicyRuleWorkloadFunc mocks the GetNetworkPolicyRuleWorkload method.
+	GetNetworkPolicyRuleWorkloadFunc func(ctx context.Context, environmentName string, id string, organizationId string) ([]apiclient.NetworkPolicyRule, error)
+
+	// GetNetworkPolicyRulesFunc mocks the GetNetworkPolicyRules method.
+	GetNetworkPolicyRulesFunc func(ctx context.Context, environmentName string, organizationId string) ([]apiclient.NetworkPolicyRule, error)
+
+	// GetOrganizationFunc mocks the GetOrganization method.
+	GetOrganizationFunc func(ctx context.Context, id string) (*apiclient.Organization, error)
+
+	// GetOrganizationBillingInfoFunc mocks the GetOrganizationBillingInfo method.
+	GetOrganizationBillingInfoFunc func(ctx context.Context, id string) (*apiclient.OrganizationBillingInfo, error)
+
+	// GetOrganizationsFunc mocks the GetOrganizations method.
+	GetOrganizationsFunc func(ctx context.Context) ([]apiclient.Organization, error)
+
+	// GetOriginSettingsFunc mocks the GetOriginSettings method.
+	GetOriginSettingsFunc func(ctx context.Context, environmentName string, id string, organizationId string) (*apiclient.OriginSettings, error)
+
+	// GetRoleFunc mocks the GetRole method.
+	GetRoleFunc func(ctx context.Context, id string) (*apiclient.Roles, error)
+
+	// GetRolesFunc mocks the GetRoles method.
+	GetRolesFunc func(ctx context.Context) ([]apiclient.Roles, error)
+
+	// GetScriptFunc mocks the GetScript method.
+	GetScriptFunc func(ctx context.Context, id string, siteId string, environmentName string, organizationId string) (*apiclient.Script, error)
+
+	// GetScriptsFunc mocks the GetScripts method.
+	GetScriptsFunc func(ctx context.Context, siteId string, environmentName string, organizationId string) ([]apiclient.Script, error)
+
+	// GetSiteFunc mocks the GetSite method.
+	GetSiteFunc func(ctx context.Context, environmentName string, id string, organizationId string) (*apiclient.Site, error)
+
+	// GetSitesFunc mocks the GetSites method.
+	GetSitesFunc func(ctx context.Context, environmentName string, organizationId string) ([]apiclient.Site, error)
+
+	// GetTaskStatusFunc mocks the GetTaskStatus method.
+	GetTaskStatusFunc func(ctx context.Context, taskId string) (*apiclient.TaskStatus, error)
+
+	// GetUserFunc mocks the GetUser method.
+	GetUserFunc func(ctx context.Context, id string) (*apiclient.User, error)
+
+	// GetUsersFunc mocks the GetUsers method.
+	GetUsersFunc func(ctx context.Context) ([]apiclient.User, error)
+
+	// GetWAFSettingsFunc mocks the GetWAFSettings method.
+	GetWAFSettingsFunc func(ctx context.Context, environmentName string, id string, organizationId string) (*apiclient.WAFSettings, error)
+
+	// GetWorkloadFunc mocks the GetWorkload method.
+	GetWorkloadFunc func(ctx context.Context, environmentName string, id string, organizationId string) (*apiclient.Workload, error)
+
+	// GetWorkloadInstancesFunc mocks the GetWorkloadInstances method.
+	GetWorkloadInstancesFunc func(ctx context.Context, environmentName string, organizationId string, workloadId string) ([]apiclient.WorkloadInstance, error)
+
+	// GetWorkloadsFunc mocks the GetWorkloads method.
+	GetWorkloadsFunc func(ctx context.Context, environmentName string, organizationId string) ([]apiclient.Workload, error)
+
+	// PurgeCDNFunc mocks the PurgeCDN method.
+	PurgeCDNFunc func(ctx context.Context, environmentName string, siteId string, options apiclient.CDNPurgeOptions, organizationId string) (*apiclient.TaskStatusResponse, error)
+
+	// UnlockUserFunc mocks the UnlockUser method.
+	UnlockUserFunc func(ctx context.Context, id string) error
+
+	// UpdateCDNSettingsFunc mocks the UpdateCDNSettings method.
+	UpdateCDNSettingsFunc func(ctx context.Context, cdnSettingsId string, newCDNSettings apiclient.CDNSettings, organizationId string) (*apiclient.TaskStatusResponse, error)
+
+	// UpdateEdgeLogicFunc mocks the UpdateEdgeLogic method.
+	UpdateEdgeLogicFunc func(ctx context.Context, edgeLogicId string, newEdgeLogic apiclient.EdgeLogic, organizationId string) (*apiclient.TaskStatusResponse, error)
+
+	// UpdateEnvironmentFunc mocks the UpdateEnvironment method.
+	UpdateEnvironmentFunc func(ctx context.Context, EnvironmentId string, newEnvironment apiclient.EnvironmentCreateRequest) (*apiclient.Environment, error)
+
+	// UpdateEnvironmentMemberFunc mocks the UpdateEnvironmentMember method.
+	UpdateEnvironmentMemberFunc func(ctx context.Context, EnvironmentId string, newEnvironment apiclient.EnvironmentMembersRequest) (*apiclient.Environment, error)
+
+	// UpdateEnvironmentMembershipFunc mocks the UpdateEnvironmentMembership method.
+	UpdateEnvironmentMembershipFunc func(ctx context.Context, EnvironmentId string, newEnvironment apiclient.EnvironmentMembershipRequest) (*apiclient.Environment, error)
+
+	// UpdateFirewallRuleFunc mocks the UpdateFirewallRule method.
+	UpdateFirewallRuleFunc func(ctx context.Context, environmentName string, firewallRuleId string, newFirewallRule apiclient.FirewallRule, organizationId string) (*apiclient.TaskStatusResponse, error)
+
+	// UpdateNetworkPolicyRuleFunc mocks the UpdateNetworkPolicyRule method.
+	UpdateNetworkPolicyRuleFunc func(ctx context.Context, networkPolicyRuleId string, newNetworkPolicyRule apiclient.NetworkPolicyRuleCreateRequest, organizationId string) ([]apiclient.NetworkPolicyRule, error)
+
+	// UpdateOriginSettingsFunc mocks the UpdateOriginSettings method.
+	UpdateOriginSettingsFunc func(ctx context.Context, originSettingsId string, newOriginSettings apiclient.OriginSettings, organizationId string) (*apiclient.OriginSettings, error)
+
+	// UpdateRoleFunc mocks the UpdateRole method.
+	UpdateRoleFunc func(ctx context.Context, roleId string, newRole apiclient.RoleCreateRequest) (*apiclient.Roles, error)
+
+	// UpdateScriptFunc mocks the UpdateScript method.
+	UpdateScriptFunc func(ctx context.Context, id string, siteId string, environmentName string, newScript apiclient.ScriptCreateRequest, organizationId string) (*apiclient.TaskStatusResponse, error)
+
+	// UpdateSiteFunc mocks the UpdateSite method.
+	UpdateSiteFunc func(ctx context.Context, siteId string, environmentName string, operationValue string, organizationId string) (*apiclient.TaskStatusResponse, error)
+
+	// UpdateUserFunc mocks the UpdateUser method.
+	UpdateUserFunc func(ctx context.Context, userId string, newUser apiclient.UserCreateRequest) (*apiclient.User, error)
+
+	// UpdateWAFSettingsFunc mocks the UpdateWAFSettings method.
+	UpdateWAFSettingsFunc func(ctx context.Context, wafSettingsId string, newWAFSettings apiclient.WAFSettings, organizationId string) (*apiclient.TaskStatusResponse, error)
+
+	// UpdateWorkloadFunc mocks the UpdateWorkload method.
+	UpdateWorkloadFunc func(ctx context.Context, workloadId string, newWorkload apiclient.WorkloadCreateRequest, organizationId string) (*apiclient.TaskStatusResponse, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// AwaitTaskResolve holds details about calls to the AwaitTaskResolve method.
+		AwaitTaskResolve []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// TaskId is the taskId argument value.
+			TaskId string
+			// MinInterval is the minInterval argument value.
+			MinInterval time.Duration
+			// MaxInterval is the maxInterval argument value.
+			MaxInterval time.Duration
+			// Timeout is the timeout argument value.
+			Timeout time.Duration
+		}
+		// AwaitTaskResolveWithDefaults holds details about calls to the AwaitTaskResolveWithDefaults method.
+		AwaitTaskResolveWithDefaults []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// TaskId is the taskId argument value.
+			TaskId string
+		}
+		// AwaitTaskResolveWithTimeout holds details about calls to the AwaitTaskResolveWithTimeout method.
+		AwaitTaskResolveWithTimeout []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// TaskId is the taskId argument value.
+			TaskId string
+			// Timeout is the timeout argument value.
+			Timeout time.Duration
+		}
+		// CreateDeliveryDomain holds details about calls to the CreateDeliveryDomain method.
+		CreateDeliveryDomain []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// SiteId is the siteId argument value.
+			SiteId string
+			// NewDeliveryDomain is the newDeliveryDomain argument value.
+			NewDeliveryDomain apiclient.DeliveryDomainCreateRequest
+			// OrganizationId is the organizationId argument value.
+			OrganizationId string
+		}
+		// CreateEnvironment holds details about calls to the CreateEnvironment method.
+		CreateEnvironment []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// NewEnvironment is the newEnvironment argument value.
+			NewEnvironment apiclient.EnvironmentCreateRequest
+		}
+		// CreateFirewallRule holds details about calls to the CreateFirewallRule method.
+		CreateFirewallRule []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// EnvironmentName is the environmentName argument value.
+			EnvironmentName string
+			// NewFirewallRule is the newFirewallRule argument value.
+			NewFirewallRule apiclient.FirewallRule
+			// OrganizationId is the organizationId argument value.
+			OrganizationId string
+		}
+		// CreateNetworkPolicyRule holds details about calls to the CreateNetworkPolicyRule method.
+		CreateNetworkPolicyRule []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// NewNetworkPolicyRule is the newNetworkPolicyRule argument value.
+			NewNetworkPolicyRule apiclient.NetworkPolicyRuleCreateRequest
+			// OrganizationId is the organizationId argument value.
+			OrganizationId string
+		}
+		// CreateOriginSettings holds details about calls to the CreateOriginSettings method.
+		CreateOriginSettings []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// NewOriginSettings is the newOriginSettings argument value.
+			NewOriginSettings apiclient.OriginSettings
+		}
+		// CreateRole holds details about calls to the CreateRole method.
+		CreateRole []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// NewRole is the newRole argument value.
+			NewRole apiclient.RoleCreateRequest
+		}
+		// CreateScript holds details about calls to the CreateScript method.
+		CreateScript []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// SiteId is the siteId argument value.
+			SiteId string
+			// EnvironmentName is the environmentName argument value.
+			EnvironmentName string
+			// NewScript is the newScript argument value.
+			NewScript apiclient.ScriptCreateRequest
+			// OrganizationId is the organizationId argument value.
+			OrganizationId string
+		}
+		// CreateSite holds details about calls to the CreateSite method.
+		CreateSite []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// NewSite is the newSite argument value.
+			NewSite apiclient.SiteCreateRequest
+			// OrganizationId is the organizationId argument value.
+			OrganizationId string
+		}
+		// CreateUser holds details about calls to the CreateUser method.
+		CreateUser []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// NewUser is the newUser argument value.
+			NewUser apiclient.UserCreateRequest
+		}
+		// CreateWorkload holds details about calls to the CreateWorkload method.
+		CreateWorkload []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// NewWorkload is the newWorkload argument value.
+			NewWorkload apiclient.WorkloadCreateRequest
+			// OrganizationId is the organizationId argument value.
+			OrganizationId string
+		}
+		// DeleteDeliveryDomain holds details about calls to the DeleteDeliveryDomain method.
+		DeleteDeliveryDomain []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// EnvironmentName is the environmentName argument value.
+			EnvironmentName string
+			// ID is the id argument value.
+			ID string
+			// OrganizationId is the organizationId argument value.
+			OrganizationId string
+		}
+		// DeleteEnvironment holds details about calls to the DeleteEnvironment method.
+		DeleteEnvironment []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+		}
+		// DeleteFirewallRule holds details about calls to the DeleteFirewallRule method.
+		DeleteFirewallRule []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// EnvironmentName is the environmentName argument value.
+			EnvironmentName string
+			// SiteId is the siteId argument value.
+			SiteId string
+			// ID is the id argument value.
+			ID string
+			// OrganizationId is the organizationId argument value.
+			OrganizationId string
+		}
+		// DeleteNetworkPolicyRule holds details about calls to the DeleteNetworkPolicyRule method.
+		DeleteNetworkPolicyRule []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// EnvironmentName is the environmentName argument value.
+			EnvironmentName string
+			// ID is the id argument value.
+			ID string
+			// OrganizationId is the organizationId argument value.
+			OrganizationId string
+			// NewNetworkPolicyRule is the newNetworkPolicyRule argument value.
+			NewNetworkPolicyRule apiclient.NetworkPolicyRuleCreateRequest
+		}
+		// DeleteRole holds details about calls to the DeleteRole method.
+		DeleteRole []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+		}
+		// DeleteScript holds details about calls to the DeleteScript method.
+		DeleteScript []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+			// SiteId is the siteId argument value.
+			SiteId string
+			// EnvironmentName is the environmentName argument value.
+			EnvironmentName string
+			// OrganizationId is the organizationId argument value.
+			OrganizationId string
+		}
+		// DeleteSite holds details about calls to the DeleteSite method.
+		DeleteSite []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// EnvironmentName is the environmentName argument value.
+			EnvironmentName string
+			// ID is the id argument value.
+			ID string
+			// OrganizationId is the organizationId argument value.
+			OrganizationId string
+		}
+		// DeleteUser holds details about calls to the DeleteUser method.
+		DeleteUser []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+		}
+		// DeleteWorkload holds details about calls to the DeleteWorkload method.
+		DeleteWorkload []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// EnvironmentName is the environmentName argument value.
+			EnvironmentName string
+			// ID is the id argument value.
+			ID string
+			// OrganizationId is the organizationId argument value.
+			OrganizationId string
+		}
+		// GetCDNSettings holds details about calls to the GetCDNSettings method.
+		GetCDNSettings []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// EnvironmentName is the environmentName argument value.
+			EnvironmentName string
+			// ID is the id argument value.
+			ID string
+			// OrganizationId is the organizationId argument value.
+			OrganizationId string
+		}
+		// GetDeliveryDomain holds details about calls to the GetDeliveryDomain method.
+		GetDeliveryDomain []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// EnvironmentName is the environmentName argument value.
+			EnvironmentName string
+			// ID is the id argument value.
+			ID string
+			// OrganizationId is the organizationId argument value.
+			OrganizationId string
+		}
+		// GetDeliveryDomains holds details about calls to the GetDeliveryDomains method.
+		GetDeliveryDomains []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// EnvironmentName is the environmentName argument value.
+			EnvironmentName string
+			// OrganizationId is the organizationId argument value.
+			OrganizationId string
+		}
+		// GetEdgeLogic holds details about calls to the GetEdgeLogic method.
+		GetEdgeLogic []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// EnvironmentName is the environmentName argument value.
+			EnvironmentName string
+			// ID is the id argument value.
+			ID string
+			// OrganizationId is the organizationId argument value.
+			OrganizationId string
+		}
+		// GetEnvironment holds details about calls to the GetEnvironment method.
+		GetEnvironment []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+		}
+		// GetEnvironments holds details about calls to the GetEnvironments method.
+		GetEnvironments []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// GetFirewallRule holds details about calls to the GetFirewallRule method.
+		GetFirewallRule []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// EnvironmentName is the environmentName argument value.
+			EnvironmentName string
+			// SiteId is the siteId argument value.
+			SiteId string
+			// ID is the id argument value.
+			ID string
+			// OrganizationId is the organizationId argument value.
+			OrganizationId string
+		}
+		// GetFirewallRules holds details about calls to the GetFirewallRules method.
+		GetFirewallRules []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// EnvironmentName is the environmentName argument value.
+			EnvironmentName string
+			// SiteId is the siteId argument value.
+			SiteId string
+			// OrganizationId is the organizationId argument value.
+			OrganizationId string
+		}
+		// GetImage holds details about calls to the GetImage method.
+		GetImage []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// EnvironmentName is the environmentName argument value.
+			EnvironmentName string
+			// ID is the id argument value.
+			ID string
+		}
+		// GetImages holds details about calls to the GetImages method.
+		GetImages []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// EnvironmentName is the environmentName argument value.
+			EnvironmentName string
+		}
+		// GetNetworkPolicyRule holds details about calls to the GetNetworkPolicyRule method.
+		GetNetworkPolicyRule []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// EnvironmentName is the environmentName argument value.
+			EnvironmentName string
+			// ID is the id argument value.
+			ID string
+			// OrganizationId is the organizationId argument value.
+			OrganizationId string
+		}
+		// GetNetworkPolicyRuleWorkload holds details about calls to the GetNetworkPolicyRuleWorkload method.
+		GetNetworkPolicyRuleWorkload []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// EnvironmentName is the environmentName argument value.
+			EnvironmentName string
+			// ID is the id argument value.
+			ID string
+			// OrganizationId is the organizationId argument value.
+			OrganizationId string
+		}
+		// GetNetworkPolicyRules holds details about calls to the GetNetworkPolicyRules method.
+		GetNetworkPolicyRules []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// EnvironmentName is the environmentName argument value.
+			EnvironmentName string
+			// OrganizationId is the organizationId argument value.
+			OrganizationId string
+		}
+		// GetOrganization holds details about calls to the GetOrganization method.
+		GetOrganization []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+		}
+		// GetOrganizationBillingInfo holds details about calls to the GetOrganizationBillingInfo method.
+		GetOrganizationBillingInfo []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+		}
+		// GetOrganizations holds details about calls to the GetOrganizations method.
+		GetOrganizations []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// GetOriginSettings holds details about calls to the GetOriginSettings method.
+		GetOriginSettings []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// EnvironmentName is the environmentName argument value.
+			EnvironmentName string
+			// ID is the id argument value.
+			ID string
+			// OrganizationId is the organizationId argument value.
+			OrganizationId string
+		}
+		// GetRole holds details about calls to the GetRole method.
+		GetRole []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+		}
+		// GetRoles holds details about calls to the GetRoles method.
+		GetRoles []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// GetScript holds details about calls to the GetScript method.
+		GetScript []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+			// SiteId is the siteId argument value.
+			SiteId string
+			// EnvironmentName is the environmentName argument value.
+			EnvironmentName string
+			// OrganizationId is the organizationId argument value.
+			OrganizationId string
+		}
+		// GetScripts holds details about calls to the GetScripts method.
+		GetScripts []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// SiteId is the siteId argument value.
+			SiteId string
+			// EnvironmentName is the environmentName argument value.
+			EnvironmentName string
+			// OrganizationId is the organizationId argument value.
+			OrganizationId string
+		}
+		// GetSite holds details about calls to the GetSite method.
+		GetSite []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// EnvironmentName is the environmentName argument value.
+			EnvironmentName string
+			// ID is the id argument value.
+			ID string
+			// OrganizationId is the organizationId argument value.
+			OrganizationId string
+		}
+		// GetSites holds details about calls to the GetSites method.
+		GetSites []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// EnvironmentName is the environmentName argument value.
+			EnvironmentName string
+			// OrganizationId is the organizationId argument value.
+			OrganizationId string
+		}
+		// GetTaskStatus holds details about calls to the GetTaskStatus method.
+		GetTaskStatus []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// TaskId is the taskId argument value.
+			TaskId string
+		}
+		// GetUser holds details about calls to the GetUser method.
+		GetUser []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+		}
+		// GetUsers holds details about calls to the GetUsers method.
+		GetUsers []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// GetWAFSettings holds details about calls to the GetWAFSettings method.
+		GetWAFSettings []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// EnvironmentName is the environmentName argument value.
+			EnvironmentName string
+			// ID is the id argument value.
+			ID string
+			// OrganizationId is the organizationId argument value.
+			OrganizationId string
+		}
+		// GetWorkload holds details about calls to the GetWorkload method.
+		GetWorkload []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// EnvironmentName is the environmentName argument value.
+			EnvironmentName string
+			// ID is the id argument value.
+			ID string
+			// OrganizationId is the organizationId argument value.
+			OrganizationId string
+		}
+		// GetWorkloadInstances holds details about calls to the GetWorkloadInstances method.
+		GetWorkloadInstances []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// EnvironmentName is the environmentName argument value.
+			EnvironmentName string
+			// OrganizationId is the organizationId argument value.
+			OrganizationId string
+			// WorkloadId is the workloadId argument value.
+			WorkloadId string
+		}
+		// GetWorkloads holds details about calls to the GetWorkloads method.
+		GetWorkloads []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// EnvironmentName is the environmentName argument value.
+			EnvironmentName string
+			// OrganizationId is the organizationId argument value.
+			OrganizationId string
+		}
+		// PurgeCDN holds details about calls to the PurgeCDN method.
+		PurgeCDN []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// EnvironmentName is the environmentName argument value.
+			EnvironmentName string
+			// SiteId is the siteId argument value.
+			SiteId string
+			// Options is the options argument value.
+			Options apiclient.CDNPurgeOptions
+			// OrganizationId is the organizationId argument value.
+			OrganizationId string
+		}
+		// UnlockUser holds details about calls to the UnlockUser method.
+		UnlockUser []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+		}
+		// UpdateCDNSettings holds details about calls to the UpdateCDNSettings method.
+		UpdateCDNSettings []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// CdnSettingsId is the cdnSettingsId argument value.
+			CdnSettingsId string
+			// NewCDNSettings is the newCDNSettings argument value.
+			NewCDNSettings apiclient.CDNSettings
+			// OrganizationId is the organizationId argument value.
+			OrganizationId string
+		}
+		// UpdateEdgeLogic holds details about calls to the UpdateEdgeLogic method.
+		UpdateEdgeLogic []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// EdgeLogicId is the edgeLogicId argument value.
+			EdgeLogicId string
+			// NewEdgeLogic is the newEdgeLogic argument value.
+			NewEdgeLogic apiclient.EdgeLogic
+			// OrganizationId is the organizationId argument value.
+			OrganizationId string
+		}
+		// UpdateEnvironment holds details about calls to the UpdateEnvironment method.
+		UpdateEnvironment []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// EnvironmentId is the EnvironmentId argument value.
+			EnvironmentId string
+			// NewEnvironment is the newEnvironment argument value.
+			NewEnvironment apiclient.EnvironmentCreateRequest
+		}
+		// UpdateEnvironmentMember holds details about calls to the UpdateEnvironmentMember method.
+		UpdateEnvironmentMember []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// EnvironmentId is the EnvironmentId argument value.
+			EnvironmentId string
+			// NewEnvironment is the newEnvironment argument value.
+			NewEnvironment apiclient.EnvironmentMembersRequest
+		}
+		// UpdateEnvironmentMembership holds details about calls to the UpdateEnvironmentMembership method.
+		UpdateEnvironmentMembership []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// EnvironmentId is the EnvironmentId argument value.
+			EnvironmentId string
+			// NewEnvironment is the newEnvironment argument value.
+			NewEnvironment apiclient.EnvironmentMembershipRequest
+		}
+		// UpdateFirewallRule holds details about calls to the UpdateFirewallRule method.
+		UpdateFirewallRule []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// EnvironmentName is the environmentName argument value.
+			EnvironmentName string
+			// FirewallRuleId is the firewallRuleId argument value.
+			FirewallRuleId string
+			// NewFirewallRule is the newFirewallRule argument value.
+			NewFirewallRule apiclient.FirewallRule
+			// OrganizationId is the organizationId argument value.
+			OrganizationId string
+		}
+		// UpdateNetworkPolicyRule holds details about calls to the UpdateNetworkPolicyRule method.
+		UpdateNetworkPolicyRule []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// NetworkPolicyRuleId is the networkPolicyRuleId argument value.
+			NetworkPolicyRuleId string
+			// NewNetworkPolicyRule is the newNetworkPolicyRule argument value.
+			NewNetworkPolicyRule apiclient.NetworkPolicyRuleCreateRequest
+			// OrganizationId is the organizationId argument value.
+			OrganizationId string
+		}
+		// UpdateOriginSettings holds details about calls to the UpdateOriginSettings method.
+		UpdateOriginSettings []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// OriginSettingsId is the originSettingsId argument value.
+			OriginSettingsId string
+			// NewOriginSettings is the newOriginSettings argument value.
+			NewOriginSettings apiclient.OriginSettings
+			// OrganizationId is the organizationId argument value.
+			OrganizationId string
+		}
+		// UpdateRole holds details about calls to the UpdateRole method.
+		UpdateRole []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// RoleId is the roleId argument value.
+			RoleId string
+			// NewRole is the newRole argument value.
+			NewRole apiclient.RoleCreateRequest
+		}
+		// UpdateScript holds details about calls to the UpdateScript method.
+		UpdateScript []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+			// SiteId is the siteId argument value.
+			SiteId string
+			// EnvironmentName is the environmentName argument value.
+			EnvironmentName string
+			// NewScript is the newScript argument value.
+			NewScript apiclient.ScriptCreateRequest
+			// OrganizationId is the organizationId argument value.
+			OrganizationId string
+		}
+		// UpdateSite holds details about calls to the UpdateSite method.
+		UpdateSite []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// SiteId is the siteId argument value.
+			SiteId string
+			// EnvironmentName is the environmentName argument value.
+			EnvironmentName string
+			// OperationValue is the operationValue argument value.
+			OperationValue string
+			// OrganizationId is the organizationId argument value.
+			OrganizationId string
+		}
+		// UpdateUser holds details about calls to the UpdateUser method.
+		UpdateUser []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserId is the userId argument value.
+			UserId string
+			// NewUser is the newUser argument value.
+			NewUser apiclient.UserCreateRequest
+		}
+		// UpdateWAFSettings holds details about calls to the UpdateWAFSettings method.
+		UpdateWAFSettings []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// WafSettingsId is the wafSettingsId argument value.
+			WafSettingsId string
+			// NewWAFSettings is the newWAFSettings argument value.
+			NewWAFSettings apiclient.WAFSettings
+			// OrganizationId is the organizationId argument value.
+			OrganizationId string
+		}
+		// UpdateWorkload holds details about calls to the UpdateWorkload method.
+		UpdateWorkload []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// WorkloadId is the workloadId argument value.
+			WorkloadId string
+			// NewWorkload is the newWorkload argument value.
+			NewWorkload apiclient.WorkloadCreateRequest
+			// OrganizationId is the organizationId argument value.
+			OrganizationId string
+		}
+	}
+	lockAwaitTaskResolve             sync.RWMutex
+	lockAwaitTaskResolveWithDefaults sync.RWMutex
+	lockAwaitTaskResolveWithTimeout  sync.RWMutex
+	lockCreateDeliveryDomain         sync.RWMutex
+	lockCreateEnvironment            sync.RWMutex
+	lockCreateFirewallRule           sync.RWMutex
+	lockCreateNetworkPolicyRule      sync.RWMutex
+	lockCreateOriginSettings         sync.RWMutex
+	lockCreateRole                   sync.RWMutex
+	lockCreateScript                 sync.RWMutex
+	lockCreateSite                   sync.RWMutex
+	lockCreateUser                   sync.RWMutex
+	lockCreateWorkload               sync.RWMutex
+	lockDeleteDeliveryDomain         sync.RWMutex
+	lockDeleteEnvironment            sync.RWMutex
+	lockDeleteFirewallRule           sync.RWMutex
+	lockDeleteNetworkPolicyRule      sync.RWMutex
+	lockDeleteRole                   sync.RWMutex
+	lockDeleteScript                 sync.RWMutex
+	lockDeleteSite                   sync.RWMutex
+	lockDeleteUser                   sync.RWMutex
+	lockDeleteWorkload               sync.RWMutex
+	lockGetCDNSettings               sync.RWMutex
+	lockGetDeliveryDomain            sync.RWMutex
+	lockGetDeliveryDomains           sync.RWMutex
+	lockGetEdgeLogic                 sync.RWMutex
+	lockGetEnvironment               sync.RWMutex
+	lockGetEnvironments              sync.RWMutex
+	lockGetFirewallRule              sync.RWMutex
+	lockGetFirewallRules             sync.RWMutex
+	lockGetImage                     sync.RWMutex
+	lockGetImages                    sync.RWMutex
+	lockGetNetworkPolicyRule         sync.RWMutex
+	lockGetNetworkPolicyRuleWorkload sync.RWMutex
+	lockGetNetworkPolicyRules        sync.RWMutex
+	lockGetOrganization              sync.RWMutex
+	lockGetOrganizationBillingInfo   sync.RWMutex
+	lockGetOrganizations             sync.RWMutex
+	lockGetOriginSettings            sync.RWMutex
+	lockGetRole                      sync.RWMutex
+	lockGetRoles                     sync.RWMutex
+	lockGetScript                    sync.RWMutex
+	lockGetScripts                   sync.RWMutex
+	lockGetSite                      sync.RWMutex
+	lockGetSites                     sync.RWMutex
+	lockGetTaskStatus                sync.RWMutex
+	lockGetUser                      sync.RWMutex
+	lockGetUsers                     sync.RWMutex
+	lockGetWAFSettings               sync.RWMutex
+	lockGetWorkload                  sync.RWMutex
+	lockGetWorkloadInstances         sync.RWMutex
+	lockGetWorkloads                 sync.RWMutex
+	lockPurgeCDN                     sync.RWMutex
+	lockUnlockUser                   sync.RWMutex
+	lockUpdateCDNSettings            sync.RWMutex
+	lockUpdateEdgeLogic              sync.RWMutex
+	lockUpdateEnvironment            sync.RWMutex
+	lockUpdateEnvironmentMember      sync.RWMutex
+	lockUpdateEnvironmentMembership  sync.RWMutex
+	lockUpdateFirewallRule           sync.RWMutex
+	lockUpdateNetworkPolicyRule      sync.RWMutex
+	lockUpdateOriginSettings         sync.RWMutex
+	lockUpdateRole                   sync.RWMutex
+	lockUpdateScript                 sync.RWMutex
+	lockUpdateSite                   sync.RWMutex
+	lockUpdateUser                   sync.RWMutex
+	lockUpdateWAFSettings            sync.RWMutex
+	lockUpdateWorkload               sync.RWMutex
+}
+
+// AwaitTaskResolve calls AwaitTaskResolveFunc.
+func (mock *APIMock) AwaitTaskResolve(ctx context.Context, taskId string, minInterval time.Duration, maxInterval time.Duration, timeout time.Duration) (*apiclient.TaskStatus, error) {
+	if mock.AwaitTaskResolveFunc == nil {
+		panic("APIMock.AwaitTaskResolveFunc: method is nil but API.AwaitTaskResolve was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		TaskId      string
+		MinInterval time.Duration
+		MaxInterval time.Duration
+		Timeout     time.Duration
+	}{
+		Ctx:         ctx,
+		TaskId:      taskId,
+		MinInterval: minInterval,
+		MaxInterval: maxInterval,
+		Timeout:     timeout,
+	}
+	mock.lockAwaitTaskResolve.Lock()
+	mock.calls.AwaitTaskResolve = append(mock.calls.AwaitTaskResolve, callInfo)
+	mock.lockAwaitTaskResolve.Unlock()
+	return mock.AwaitTaskResolveFunc(ctx, taskId, minInterval, maxInterval, timeout)
+}
+
+// AwaitTaskResolveCalls gets all the calls that were made to AwaitTaskResolve.
+// Check the length with:
+//
+//	len(mockedAPI.AwaitTaskResolveCalls())
+func (mock *APIMock) AwaitTaskResolveCalls() []struct {
+	Ctx         context.Context
+	TaskId      string
+	MinInterval time.Duration
+	MaxInterval time.Duration
+	Timeout     time.Duration
+} {
+	var calls []struct {
+		Ctx         context.Context
+		TaskId      string
+		MinInterval time.Duration
+		MaxInterval time.Duration
+		Timeout     time.Duration
+	}
+	mock.lockAwaitTaskResolve.RLock()
+	calls = mock.calls.AwaitTaskResolve
+	mock.lockAwaitTaskResolve.RUnlock()
+	return calls
+}
+
+// AwaitTaskResolveWithDefaults calls AwaitTaskResolveWithDefaultsFunc.
+func (mock *APIMock) AwaitTaskResolveWithDefaults(ctx context.Context, taskId string) (*apiclient.TaskStatus, error) {
+	if mock.AwaitTaskResolveWithDefaultsFunc == nil {
+		panic("APIMock.AwaitTaskResolveWithDefaultsFunc: method is nil but API.AwaitTaskResolveWithDefaults was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		TaskId string
+	}{
+		Ctx:    ctx,
+		TaskId: taskId,
+	}
+	mock.lockAwaitTaskResolveWithDefaults.Lock()
+	mock.calls.AwaitTaskResolveWithDefaults = append(mock.calls.AwaitTaskResolveWithDefaults, callInfo)
+	mock.lockAwaitTaskResolveWithDefaults.Unlock()
+	return mock.AwaitTaskResolveWithDefaultsFunc(ctx, taskId)
+}
+
+// AwaitTaskResolveWithDefaultsCalls gets all the calls that were made to AwaitTaskResolveWithDefaults.
+// Check the length with:
+//
+//	len(mockedAPI.AwaitTaskResolveWithDefaultsCalls())
+func (mock *APIMock) AwaitTaskResolveWithDefaultsCalls() []struct {
+	Ctx    context.Context
+	TaskId string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		TaskId string
+	}
+	mock.lockAwaitTaskResolveWithDefaults.RLock()
+	calls = mock.calls.AwaitTaskResolveWithDefaults
+	mock.lockAwaitTaskResolveWithDefaults.RUnlock()
+	return calls
+}
+
+// AwaitTaskResolveWithTimeout calls AwaitTaskResolveWithTimeoutFunc.
+func (mock *APIMock) AwaitTaskResolveWithTimeout(ctx context.Context, taskId string, timeout time.Duration) (*apiclient.TaskStatus, error) {
+	if mock.AwaitTaskResolveWithTimeoutFunc == nil {
+		panic("APIMock.AwaitTaskResolveWithTimeoutFunc: method is nil but API.AwaitTaskResolveWithTimeout was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		TaskId  string
+		Timeout time.Duration
+	}{
+		Ctx:     ctx,
+		TaskId:  taskId,
+		Timeout: timeout,
+	}
+	mock.lockAwaitTaskResolveWithTimeout.Lock()
+	mock.calls.AwaitTaskResolveWithTimeout = append(mock.calls.AwaitTaskResolveWithTimeout, callInfo)
+	mock.lockAwaitTaskResolveWithTimeout.Unlock()
+	return mock.AwaitTaskResolveWithTimeoutFunc(ctx, taskId, timeout)
+}
+
+// AwaitTaskResolveWithTimeoutCalls gets all the calls that were made to AwaitTaskResolveWithTimeout.
+// Check the length with:
+//
+//	len(mockedAPI.AwaitTaskResolveWithTimeoutCalls())
+func (mock *APIMock) AwaitTaskResolveWithTimeoutCalls() []struct {
+	Ctx     context.Context
+	TaskId  string
+	Timeout time.Duration
+} {
+	var calls []struct {
+		Ctx     context.Context
+		TaskId  string
+		Timeout time.Duration
+	}
+	mock.lockAwaitTaskResolveWithTimeout.RLock()
+	calls = mock.calls.AwaitTaskResolveWithTimeout
+	mock.lockAwaitTaskResolveWithTimeout.RUnlock()
+	return calls
+}
+
+// CreateDeliveryDomain calls CreateDeliveryDomainFunc.
+func (mock *APIMock) CreateDeliveryDomain(ctx context.Context, siteId string, newDeliveryDomain apiclient.DeliveryDomainCreateRequest, organizationId string) (*apiclient.TaskStatusResponse, error) {
+	if mock.CreateDeliveryDomainFunc == nil {
+		panic("APIMock.CreateDeliveryDomainFunc: method is nil but API.CreateDeliveryDomain was just called")
+	}
+	callInfo := struct {
+		Ctx               context.Context
+		SiteId            string
+		NewDeliveryDomain apiclient.DeliveryDomainCreateRequest
+		OrganizationId    string
+	}{
+		Ctx:               ctx,
+		SiteId:            siteId,
+		NewDeliveryDomain: newDeliveryDomain,
+		OrganizationId:    organizationId,
+	}
+	mock.lockCreateDeliveryDomain.Lock()
+	mock.calls.CreateDeliveryDomain = append(mock.calls.CreateDeliveryDomain, callInfo)
+	mock.lockCreateDeliveryDomain.Unlock()
+	return mock.CreateDeliveryDomainFunc(ctx, siteId, newDeliveryDomain, organizationId)
+}
+
+// CreateDeliveryDomainCalls gets all the calls that were made to CreateDeliveryDomain.
+// Check the length with:
+//
+//	len(mockedAPI.CreateDeliveryDomainCalls())
+func (mock *APIMock) CreateDeliveryDomainCalls() []struct {
+	Ctx               context.Context
+	SiteId            string
+	NewDeliveryDomain apiclient.DeliveryDomainCreateRequest
+	OrganizationId    string
+} {
+	var calls []struct {
+		Ctx               context.Context
+		SiteId            string
+		NewDeliveryDomain apiclient.DeliveryDomainCreateRequest
+		OrganizationId    string
+	}
+	mock.lockCreateDeliveryDomain.RLock()
+	calls = mock.calls.CreateDeliveryDomain
+	mock.lockCreateDeliveryDomain.RUnlock()
+	return calls
+}
+
+// CreateEnvironment calls CreateEnvironmentFunc.
+func (mock *APIMock) CreateEnvironment(ctx context.Context, newEnvironment apiclient.EnvironmentCreateRequest) (*apiclient.Environment, error) {
+	if mock.CreateEnvironmentFunc == nil {
+		panic("APIMock.CreateEnvironmentFunc: method is nil but API.CreateEnvironment was just called")
+	}
+	callInfo := struct {
+		Ctx            context.Context
+		NewEnvironment apiclient.EnvironmentCreateRequest
+	}{
+		Ctx:            ctx,
+		NewEnvironment: newEnvironment,
+	}
+	mock.lockCreateEnvironment.Lock()
+	mock.calls.CreateEnvironment = append(mock.calls.CreateEnvironment, callInfo)
+	mock.lockCreateEnvironment.Unlock()
+	return mock.CreateEnvironmentFunc(ctx, newEnvironment)
+}
+
+// CreateEnvironmentCalls gets all the calls that were made to CreateEnvironment.
+// Check the length with:
+//
+//	len(mockedAPI.CreateEnvironmentCalls())
+func (mock *APIMock) CreateEnvironmentCalls() []struct {
+	Ctx            context.Context
+	NewEnvironment apiclient.EnvironmentCreateRequest
+} {
+	var calls []struct {
+		Ctx            context.Context
+		NewEnvironment apiclient.EnvironmentCreateRequest
+	}
+	mock.lockCreateEnvironment.RLock()
+	calls = mock.calls.CreateEnvironment
+	mock.lockCreateEnvironment.RUnlock()
+	return calls
+}
+
+// CreateFirewallRule calls CreateFirewallRuleFunc.
+func (mock *APIMock) CreateFirewallRule(ctx context.Context, environmentName string, newFirewallRule apiclient.FirewallRule, organizationId string) (*apiclient.TaskStatusResponse, error) {
+	if mock.CreateFirewallRuleFunc == nil {
+		panic("APIMock.CreateFirewallRuleFunc: method is nil but API.CreateFirewallRule was just called")
+	}
+	callInfo := struct {
+		Ctx             context.Context
+		EnvironmentName string
+		NewFirewallRule apiclient.FirewallRule
+		OrganizationId  string
+	}{
+		Ctx:             ctx,
+		EnvironmentName: environmentName,
+		NewFirewallRule: newFirewallRule,
+		OrganizationId:  organizationId,
+	}
+	mock.lockCreateFirewallRule.Lock()
+	mock.calls.CreateFirewallRule = append(mock.calls.CreateFirewallRule, callInfo)
+	mock.lockCreateFirewallRule.Unlock()
+	return mock.CreateFirewallRuleFunc(ctx, environmentName, newFirewallRule, organizationId)
+}
+
+// CreateFirewallRuleCalls gets all the calls that were made to CreateFirewallRule.
+// Check the length with:
+//
+//	len(mockedAPI.CreateFirewallRuleCalls())
+func (mock *APIMock) CreateFirewallRuleCalls() []struct {
+	Ctx             context.Context
+	EnvironmentName string
+	NewFirewallRule apiclient.FirewallRule
+	OrganizationId  string
+} {
+	var calls []struct {
+		Ctx             context.Context
+		EnvironmentName string
+		NewFirewallRule apiclient.FirewallRule
+		OrganizationId  string
+	}
+	mock.lockCreateFirewallRule.RLock()
+	calls = mock.calls.CreateFirewallRule
+	mock.lockCreateFirewallRule.RUnlock()
+	return calls
+}
+
+// CreateNetworkPolicyRule calls CreateNetworkPolicyRuleFunc.
+func (mock *APIMock) CreateNetworkPolicyRule(ctx context.Context, newNetworkPolicyRule apiclient.NetworkPolicyRuleCreateRequest, organizationId string) ([]apiclient.NetworkPolicyRule, error) {
+	if mock.CreateNetworkPolicyRuleFunc == nil {
+		panic("APIMock.CreateNetworkPolicyRuleFunc: method is nil but API.CreateNetworkPolicyRule was just called")
+	}
+	callInfo := struct {
+		Ctx                  context.Context
+		NewNetworkPolicyRule apiclient.NetworkPolicyRuleCreateRequest
+		OrganizationId       string
+	}{
+		Ctx:                  ctx,
+		NewNetworkPolicyRule: newNetworkPolicyRule,
+		OrganizationId:       organizationId,
+	}
+	mock.lockCreateNetworkPolicyRule.Lock()
+	mock.calls.CreateNetworkPolicyRule = append(mock.calls.CreateNetworkPolicyRule, callInfo)
+	mock.lockCreateNetworkPolicyRule.Unlock()
+	return mock.CreateNetworkPolicyRuleFunc(ctx, newNetworkPolicyRule, organizationId)
+}
+
+// CreateNetworkPolicyRuleCalls gets all the calls that were made to CreateNetworkPolicyRule.
+// Check the length with:
+//
+//	len(mockedAPI.CreateNetworkPolicyRuleCalls())
+func (mock *APIMock) CreateNetworkPolicyRuleCalls() []struct {
+	Ctx                  context.Context
+	NewNetworkPolicyRule apiclient.NetworkPolicyRuleCreateRequest
+	OrganizationId       string
+} {
+	var calls []struct {
+		Ctx                  context.Context
+		NewNetworkPolicyRule apiclient.NetworkPolicyRuleCreateRequest
+		OrganizationId       string
+	}
+	mock.lockCreateNetworkPolicyRule.RLock()
+	calls = mock.calls.CreateNetworkPolicyRule
+	mock.lockCreateNetworkPolicyRule.RUnlock()
+	return calls
+}
+
+// CreateOriginSettings calls CreateOriginSettingsFunc.
+func (mock *APIMock) CreateOriginSettings(ctx context.Context, newOriginSettings apiclient.OriginSettings) (*apiclient.OriginSettings, error) {
+	if mock.CreateOriginSettingsFunc == nil {
+		panic("APIMock.CreateOriginSettingsFunc: method is nil but API.CreateOriginSettings was just called")
+	}
+	callInfo := struct {
+		Ctx               context.Context
+		NewOriginSettings apiclient.OriginSettings
+	}{
+		Ctx:               ctx,
+		NewOriginSettings: newOriginSettings,
+	}
+	mock.lockCreateOriginSettings.Lock()
+	mock.calls.CreateOriginSettings = append(mock.calls.CreateOriginSettings, callInfo)
+	mock.lockCreateOriginSettings.Unlock()
+	return mock.CreateOriginSettingsFunc(ctx, newOriginSettings)
+}
+
+// CreateOriginSettingsCalls gets all the calls that were made to CreateOriginSettings.
+// Check the length with:
+//
+//	len(mockedAPI.CreateOriginSettingsCalls())
+func (mock *APIMock) CreateOriginSettingsCalls() []struct {
+	Ctx               context.Context
+	NewOriginSettings apiclient.OriginSettings
+} {
+	var calls []struct {
+		Ctx               context.Context
+		NewOriginSettings apiclient.OriginSettings
+	}
+	mock.lockCreateOriginSettings.RLock()
+	calls = mock.calls.CreateOriginSettings
+	mock.lockCreateOriginSettings.RUnlock()
+	return calls
+}
+
+// CreateRole calls CreateRoleFunc.
+func (mock *APIMock) CreateRole(ctx context.Context, newRole apiclient.RoleCreateRequest) (*apiclient.Roles, error) {
+	if mock.CreateRoleFunc == nil {
+		panic("APIMock.CreateRoleFunc: method is nil but API.CreateRole was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		NewRole apiclient.RoleCreateRequest
+	}{
+		Ctx:     ctx,
+		NewRole: newRole,
+	}
+	mock.lockCreateRole.Lock()
+	mock.calls.CreateRole = append(mock.calls.CreateRole, callInfo)
+	mock.lockCreateRole.Unlock()
+	return mock.CreateRoleFunc(ctx, newRole)
+}
+
+// CreateRoleCalls gets all the calls that were made to CreateRole.
+// Check the length with:
+//
+//	len(mockedAPI.CreateRoleCalls())
+func (mock *APIMock) CreateRoleCalls() []struct {
+	Ctx     context.Context
+	NewRole apiclient.RoleCreateRequest
+} {
+	var calls []struct {
+		Ctx     context.Context
+		NewRole apiclient.RoleCreateRequest
+	}
+	mock.lockCreateRole.RLock()
+	calls = mock.calls.CreateRole
+	mock.lockCreateRole.RUnlock()
+	return calls
+}
+
+// CreateScript calls CreateScriptFunc.
+func (mock *APIMock) CreateScript(ctx context.Context, siteId string, environmentName string, newScript apiclient.ScriptCreateRequest, organizationId string) (*apiclient.TaskStatusResponse, error) {
+	if mock.CreateScriptFunc == nil {
+		panic("APIMock.CreateScriptFunc: method is nil but API.CreateScript was just called")
+	}
+	callInfo := struct {
+		Ctx             context.Context
+		SiteId          string
+		EnvironmentName string
+		NewScript       apiclient.ScriptCreateRequest
+		OrganizationId  string
+	}{
+		Ctx:             ctx,
+		SiteId:          siteId,
+		EnvironmentName: environmentName,
+		NewScript:       newScript,
+		OrganizationId:  organizationId,
+	}
+	mock.lockCreateScript.Lock()
+	mock.calls.CreateScript = append(mock.calls.CreateScript, callInfo)
+	mock.lockCreateScript.Unlock()
+	return mock.CreateScriptFunc(ctx, siteId, environmentName, newScript, organizationId)
+}
+
+// CreateScriptCalls gets all the calls that were made to CreateScript.
+// Check the length with:
+//
+//	len(mockedAPI.CreateScriptCalls())
+func (mock *APIMock) CreateScriptCalls() []struct {
+	Ctx             context.Context
+	SiteId          string
+	EnvironmentName string
+	NewScript       apiclient.ScriptCreateRequest
+	OrganizationId  string
+} {
+	var calls []struct {
+		Ctx             context.Context
+		SiteId          string
+		EnvironmentName string
+		NewScript       apiclient.ScriptCreateRequest
+		OrganizationId  string
+	}
+	mock.lockCreateScript.RLock()
+	calls = mock.calls.CreateScript
+	mock.lockCreateScript.RUnlock()
+	return calls
+}
+
+// CreateSite calls CreateSiteFunc.
+func (mock *APIMock) CreateSite(ctx context.Context, newSite apiclient.SiteCreateRequest, organizationId string) (*apiclient.TaskStatusResponse, error) {
+	if mock.CreateSiteFunc == nil {
+		panic("APIMock.CreateSiteFunc: method is nil but API.CreateSite was just called")
+	}
+	callInfo := struct {
+		Ctx            context.Context
+		NewSite        apiclient.SiteCreateRequest
+		OrganizationId string
+	}{
+		Ctx:            ctx,
+		NewSite:        newSite,
+		OrganizationId: organizationId,
+	}
+	mock.lockCreateSite.Lock()
+	mock.calls.CreateSite = append(mock.calls.CreateSite, callInfo)
+	mock.lockCreateSite.Unlock()
+	return mock.CreateSiteFunc(ctx, newSite, organizationId)
+}
+
+// CreateSiteCalls gets all the calls that were made to CreateSite.
+// Check the length with:
+//
+//	len(mockedAPI.CreateSiteCalls())
+func (mock *APIMock) CreateSiteCalls() []struct {
+	Ctx            context.Context
+	NewSite        apiclient.SiteCreateRequest
+	OrganizationId string
+} {
+	var calls []struct {
+		Ctx            context.Context
+		NewSite        apiclient.SiteCreateRequest
+		OrganizationId string
+	}
+	mock.lockCreateSite.RLock()
+	calls = mock.calls.CreateSite
+	mock.lockCreateSite.RUnlock()
+	return calls
+}
+
+// CreateUser calls CreateUserFunc.
+func (mock *APIMock) CreateUser(ctx context.Context, newUser apiclient.UserCreateRequest) (*apiclient.User, error) {
+	if mock.CreateUserFunc == nil {
+		panic("APIMock.CreateUserFunc: method is nil but API.CreateUser was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		NewUser apiclient.UserCreateRequest
+	}{
+		Ctx:     ctx,
+		NewUser: newUser,
+	}
+	mock.lockCreateUser.Lock()
+	mock.calls.CreateUser = append(mock.calls.CreateUser, callInfo)
+	mock.lockCreateUser.Unlock()
+	return mock.CreateUserFunc(ctx, newUser)
+}
+
+// CreateUserCalls gets all the calls that were made to CreateUser.
+// Check the length with:
+//
+//	len(mockedAPI.CreateUserCalls())
+func (mock *APIMock) CreateUserCalls() []struct {
+	Ctx     context.Context
+	NewUser apiclient.UserCreateRequest
+} {
+	var calls []struct {
+		Ctx     context.Context
+		NewUser apiclient.UserCreateRequest
+	}
+	mock.lockCreateUser.RLock()
+	calls = mock.calls.CreateUser
+	mock.lockCreateUser.RUnlock()
+	return calls
+}
+
+// CreateWorkload calls CreateWorkloadFunc.
+func (mock *APIMock) CreateWorkload(ctx context.Context, newWorkload apiclient.WorkloadCreateRequest, organizationId string) (*apiclient.TaskStatusResponse, error) {
+	if mock.CreateWorkloadFunc == nil {
+		panic("APIMock.CreateWorkloadFunc: method is nil but API.CreateWorkload was just called")
+	}
+	callInfo := struct {
+		Ctx            context.Context
+		NewWorkload    apiclient.WorkloadCreateRequest
+		OrganizationId string
+	}{
+		Ctx:            ctx,
+		NewWorkload:    newWorkload,
+		OrganizationId: organizationId,
+	}
+	mock.lockCreateWorkload.Lock()
+	mock.calls.CreateWorkload = append(mock.calls.CreateWorkload, callInfo)
+	mock.lockCreateWorkload.Unlock()
+	return mock.CreateWorkloadFunc(ctx, newWorkload, organizationId)
+}
+
+// CreateWorkloadCalls gets all the calls that were made to CreateWorkload.
+// Check the length with:
+//
+//	len(mockedAPI.CreateWorkloadCalls())
+func (mock *APIMock) CreateWorkloadCalls() []struct {
+	Ctx            context.Context
+	NewWorkload    apiclient.WorkloadCreateRequest
+	OrganizationId string
+} {
+	var calls []struct {
+		Ctx            context.Context
+		NewWorkload    apiclient.WorkloadCreateRequest
+		OrganizationId string
+	}
+	mock.lockCreateWorkload.RLock()
+	calls = mock.calls.CreateWorkload
+	mock.lockCreateWorkload.RUnlock()
+	return calls
+}
+
+// DeleteDeliveryDomain calls DeleteDeliveryDomainFunc.
+func (mock *APIMock) DeleteDeliveryDomain(ctx context.Context, environmentName string, id string, organizationId string) error {
+	if mock.DeleteDeliveryDomainFunc == nil {
+		panic("APIMock.DeleteDeliveryDomainFunc: method is nil but API.DeleteDeliveryDomain was just called")
+	}
+	callInfo := struct {
+		Ctx             context.Context
+		EnvironmentName string
+		ID              string
+		OrganizationId  string
+	}{
+		Ctx:             ctx,
+		EnvironmentName: environmentName,
+		ID:              id,
+		OrganizationId:  organizationId,
+	}
+	mock.lockDeleteDeliveryDomain.Lock()
+	mock.calls.DeleteDeliveryDomain = append(mock.calls.DeleteDeliveryDomain, callInfo)
+	mock.lockDeleteDeliveryDomain.Unlock()
+	return mock.DeleteDeliveryDomainFunc(ctx, environmentName, id, organizationId)
+}
+
+// DeleteDeliveryDomainCalls gets all the calls that were made to DeleteDeliveryDomain.
+// Check the length with:
+//
+//	len(mockedAPI.DeleteDeliveryDomainCalls())
+func (mock *APIMock) DeleteDeliveryDomainCalls() []struct {
+	Ctx             context.Context
+	EnvironmentName string
+	ID              string
+	OrganizationId  string
+} {
+	var calls []struct {
+		Ctx             context.Context
+		EnvironmentName string
+		ID              string
+		OrganizationId  string
+	}
+	mock.lockDeleteDeliveryDomain.RLock()
+	calls = mock.calls.DeleteDeliveryDomain
+	mock.lockDeleteDeliveryDomain.RUnlock()
+	return calls
+}
+
+// DeleteEnvironment calls DeleteEnvironmentFunc.
+func (mock *APIMock) DeleteEnvironment(ctx context.Context, id string) error {
+	if mock.DeleteEnvironmentFunc == nil {
+		panic("APIMock.DeleteEnvironmentFunc: method is nil but API.DeleteEnvironment was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  string
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockDeleteEnvironment.Lock()
+	mock.calls.DeleteEnvironment = append(mock.calls.DeleteEnvironment, callInfo)
+	mock.lockDeleteEnvironment.Unlock()
+	return mock.DeleteEnvironmentFunc(ctx, id)
+}
+
+// DeleteEnvironmentCalls gets all the calls that were made to DeleteEnvironment.
+// Check the length with:
+//
+//	len(mockedAPI.DeleteEnvironmentCalls())
+func (mock *APIMock) DeleteEnvironmentCalls() []struct {
+	Ctx context.Context
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  string
+	}
+	mock.lockDeleteEnvironment.RLock()
+	calls = mock.calls.DeleteEnvironment
+	mock.lockDeleteEnvironment.RUnlock()
+	return calls
+}
+
+// DeleteFirewallRule calls DeleteFirewallRuleFunc.
+func (mock *APIMock) DeleteFirewallRule(ctx context.Context, environmentName string, siteId string, id string, organizationId string) error {
+	if mock.DeleteFirewallRuleFunc == nil {
+		panic("APIMock.DeleteFirewallRuleFunc: method is nil but API.DeleteFirewallRule was just called")
+	}
+	callInfo := struct {
+		Ctx             context.Context
+		EnvironmentName string
+		SiteId          string
+		ID              string
+		OrganizationId  string
+	}{
+		Ctx:             ctx,
+		EnvironmentName: environmentName,
+		SiteId:          siteId,
+		ID:              id,
+		OrganizationId:  organizationId,
+	}
+	mock.lockDeleteFirewallRule.Lock()
+	mock.calls.DeleteFirewallRule = append(mock.calls.DeleteFirewallRule, callInfo)
+	mock.lockDeleteFirewallRule.Unlock()
+	return mock.DeleteFirewallRuleFunc(ctx, environmentName, siteId, id, organizationId)
+}
+
+// DeleteFirewallRuleCalls gets all the calls that were made to DeleteFirewallRule.
+// Check the length with:
+//
+//	len(mockedAPI.DeleteFirewallRuleCalls())
+func (mock *APIMock) DeleteFirewallRuleCalls() []struct {
+	Ctx             context.Context
+	EnvironmentName string
+	SiteId          string
+	ID              string
+	OrganizationId  string
+} {
+	var calls []struct {
+		Ctx             context.Context
+		EnvironmentName string
+		SiteId          string
+		ID              string
+		OrganizationId  string
+	}
+	mock.lockDeleteFirewallRule.RLock()
+	calls = mock.calls.DeleteFirewallRule
+	mock.lockDeleteFirewallRule.RUnlock()
+	return calls
+}
+
+// DeleteNetworkPolicyRule calls DeleteNetworkPolicyRuleFunc.
+func (mock *APIMock) DeleteNetworkPolicyRule(ctx context.Context, environmentName string, id string, organizationId string, newNetworkPolicyRule apiclient.NetworkPolicyRuleCreateRequest) error {
+	if mock.DeleteNetworkPolicyRuleFunc == nil {
+		panic("APIMock.DeleteNetworkPolicyRuleFunc: method is nil but API.DeleteNetworkPolicyRule was just called")
+	}
+	callInfo := struct {
+		Ctx                  context.Context
+		EnvironmentName      string
+		ID                   string
+		OrganizationId       string
+		NewNetworkPolicyRule apiclient.NetworkPolicyRuleCreateRequest
+	}{
+		Ctx:                  ctx,
+		EnvironmentName:      environmentName,
+		ID:                   id,
+		OrganizationId:       organizationId,
+		NewNetworkPolicyRule: newNetworkPolicyRule,
+	}
+	mock.lockDeleteNetworkPolicyRule.Lock()
+	mock.calls.DeleteNetworkPolicyRule = append(mock.calls.DeleteNetworkPolicyRule, callInfo)
+	mock.lockDeleteNetworkPolicyRule.Unlock()
+	return mock.DeleteNetworkPolicyRuleFunc(ctx, environmentName, id, organizationId, newNetworkPolicyRule)
+}
+
+// DeleteNetworkPolicyRuleCalls gets all the calls that were made to DeleteNetworkPolicyRule.
+// Check the length with:
+//
+//	len(mockedAPI.DeleteNetworkPolicyRuleCalls())
+func (mock *APIMock) DeleteNetworkPolicyRuleCalls() []struct {
+	Ctx                  context.Context
+	EnvironmentName      string
+	ID                   string
+	OrganizationId       string
+	NewNetworkPolicyRule apiclient.NetworkPolicyRuleCreateRequest
+} {
+	var calls []struct {
+		Ctx                  context.Context
+		EnvironmentName      string
+		ID                   string
+		OrganizationId       string
+		NewNetworkPolicyRule apiclient.NetworkPolicyRuleCreateRequest
+	}
+	mock.lockDeleteNetworkPolicyRule.RLock()
+	calls = mock.calls.DeleteNetworkPolicyRule
+	mock.lockDeleteNetworkPolicyRule.RUnlock()
+	return calls
+}
+
+// DeleteRole calls DeleteRoleFunc.
+func (mock *APIMock) DeleteRole(ctx context.Context, id string) error {
+	if mock.DeleteRoleFunc == nil {
+		panic("APIMock.DeleteRoleFunc: method is nil but API.DeleteRole was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  string
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockDeleteRole.Lock()
+	mock.calls.DeleteRole = append(mock.calls.DeleteRole, callInfo)
+	mock.lockDeleteRole.Unlock()
+	return mock.DeleteRoleFunc(ctx, id)
+}
+
+// DeleteRoleCalls gets all the calls that were made to DeleteRole.
+// Check the length with:
+//
+//	len(mockedAPI.DeleteRoleCalls())
+func (mock *APIMock) DeleteRoleCalls() []struct {
+	Ctx context.Context
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  string
+	}
+	mock.lockDeleteRole.RLock()
+	calls = mock.calls.DeleteRole
+	mock.lockDeleteRole.RUnlock()
+	return calls
+}
+
+// DeleteScript calls DeleteScriptFunc.
+func (mock *APIMock) DeleteScript(ctx context.Context, id string, siteId string, environmentName string, organizationId string) error {
+	if mock.DeleteScriptFunc == nil {
+		panic("APIMock.DeleteScriptFunc: method is nil but API.DeleteScript was just called")
+	}
+	callInfo := struct {
+		Ctx             context.Context
+		ID              string
+		SiteId          string
+		EnvironmentName string
+		OrganizationId  string
+	}{
+		Ctx:             ctx,
+		ID:              id,
+		SiteId:          siteId,
+		EnvironmentName: environmentName,
+		OrganizationId:  organizationId,
+	}
+	mock.lockDeleteScript.Lock()
+	mock.calls.DeleteScript = append(mock.calls.DeleteScript, callInfo)
+	mock.lockDeleteScript.Unlock()
+	return mock.DeleteScriptFunc(ctx, id, siteId, environmentName, organizationId)
+}
+
+// DeleteScriptCalls gets all the calls that were made to DeleteScript.
+// Check the length with:
+//
+//	len(mockedAPI.DeleteScriptCalls())
+func (mock *APIMock) DeleteScriptCalls() []struct {
+	Ctx             context.Context
+	ID              string
+	SiteId          string
+	EnvironmentName string
+	OrganizationId  string
+} {
+	var calls []struct {
+		Ctx             context.Context
+		ID              string
+		SiteId          string
+		EnvironmentName string
+		OrganizationId  string
+	}
+	mock.lockDeleteScript.RLock()
+	calls = mock.calls.DeleteScript
+	mock.lockDeleteScript.RUnlock()
+	return calls
+}
+
+// DeleteSite calls DeleteSiteFunc.
+func (mock *APIMock) DeleteSite(ctx context.Context, environmentName string, id string, organizationId string) error {
+	if mock.DeleteSiteFunc == nil {
+		panic("APIMock.DeleteSiteFunc: method is nil but API.DeleteSite was just called")
+	}
+	callInfo := struct {
+		Ctx             context.Context
+		EnvironmentName string
+		ID              string
+		OrganizationId  string
+	}{
+		Ctx:             ctx,
+		EnvironmentName: environmentName,
+		ID:              id,
+		OrganizationId:  organizationId,
+	}
+	mock.lockDeleteSite.Lock()
+	mock.calls.DeleteSite = append(mock.calls.DeleteSite, callInfo)
+	mock.lockDeleteSite.Unlock()
+	return mock.DeleteSiteFunc(ctx, environmentName, id, organizationId)
+}
+
+// DeleteSiteCalls gets all the calls that were made to DeleteSite.
+// Check the length with:
+//
+//	len(mockedAPI.DeleteSiteCalls())
+func (mock *APIMock) DeleteSiteCalls() []struct {
+	Ctx             context.Context
+	EnvironmentName string
+	ID              string
+	OrganizationId  string
+} {
+	var calls []struct {
+		Ctx             context.Context
+		EnvironmentName string
+		ID              string
+		OrganizationId  string
+	}
+	mock.lockDeleteSite.RLock()
+	calls = mock.calls.DeleteSite
+	mock.lockDeleteSite.RUnlock()
+	return calls
+}
+
+// DeleteUser calls DeleteUserFunc.
+func (mock *APIMock) DeleteUser(ctx context.Context, id string) error {
+	if mock.DeleteUserFunc == nil {
+		panic("APIMock.DeleteUserFunc: method is nil but API.DeleteUser was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  string
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockDeleteUser.Lock()
+	mock.calls.DeleteUser = append(mock.calls.DeleteUser, callInfo)
+	mock.lockDeleteUser.Unlock()
+	return mock.DeleteUserFunc(ctx, id)
+}
+
+// DeleteUserCalls gets all the calls that were made to DeleteUser.
+// Check the length with:
+//
+//	len(mockedAPI.DeleteUserCalls())
+func (mock *APIMock) DeleteUserCalls() []struct {
+	Ctx context.Context
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  string
+	}
+	mock.lockDeleteUser.RLock()
+	calls = mock.calls.DeleteUser
+	mock.lockDeleteUser.RUnlock()
+	return calls
+}
+
+// DeleteWorkload calls DeleteWorkloadFunc.
+func (mock *APIMock) DeleteWorkload(ctx context.Context, environmentName string, id string, organizationId string) error {
+	if mock.DeleteWorkloadFunc == nil {
+		panic("APIMock.DeleteWorkloadFunc: method is nil but API.DeleteWorkload was just called")
+	}
+	callInfo := struct {
+		Ctx             context.Context
+		EnvironmentName string
+		ID              string
+		OrganizationId  string
+	}{
+		Ctx:             ctx,
+		EnvironmentName: environmentName,
+		ID:              id,
+		OrganizationId:  organizationId,
+	}
+	mock.lockDeleteWorkload.Lock()
+	mock.calls.DeleteWorkload = append(mock.calls.DeleteWorkload, callInfo)
+	mock.lockDeleteWorkload.Unlock()
+	return mock.DeleteWorkloadFunc(ctx, environmentName, id, organizationId)
+}
+
+// DeleteWorkloadCalls gets all the calls that were made to DeleteWorkload.
+// Check the length with:
+//
+//	len(mockedAPI.DeleteWorkloadCalls())
+func (mock *APIMock) DeleteWorkloadCalls() []struct {
+	Ctx             context.Context
+	EnvironmentName string
+	ID              string
+	OrganizationId  string
+} {
+	var calls []struct {
+		Ctx             context.Context
+		EnvironmentName string
+		ID              string
+		OrganizationId  string
+	}
+	mock.lockDeleteWorkload.RLock()
+	calls = mock.calls.DeleteWorkload
+	mock.lockDeleteWorkload.RUnlock()
+	return calls
+}
+
+// GetCDNSettings calls GetCDNSettingsFunc.
+func (mock *APIMock) GetCDNSettings(ctx context.Context, environmentName string, id string, organizationId string) (*apiclient.CDNSettings, error) {
+	if mock.GetCDNSettingsFunc == nil {
+		panic("APIMock.GetCDNSettingsFunc: method is nil but API.GetCDNSettings was just called")
+	}
+	callInfo := struct {
+		Ctx             context.Context
+		EnvironmentName string
+		ID              string
+		OrganizationId  string
+	}{
+		Ctx:             ctx,
+		EnvironmentName: environmentName,
+		ID:              id,
+		OrganizationId:  organizationId,
+	}
+	mock.lockGetCDNSettings.Lock()
+	mock.calls.GetCDNSettings = append(mock.calls.GetCDNSettings, callInfo)
+	mock.lockGetCDNSettings.Unlock()
+	return mock.GetCDNSettingsFunc(ctx, environmentName, id, organizationId)
+}
+
+// GetCDNSettingsCalls gets all the calls that were made to GetCDNSettings.
+// Check the length with:
+//
+//	len(mockedAPI.GetCDNSettingsCalls())
+func (mock *APIMock) GetCDNSettingsCalls() []struct {
+	Ctx             context.Context
+	EnvironmentName string
+	ID              string
+	OrganizationId  string
+} {
+	var calls []struct {
+		Ctx             context.Context
+		EnvironmentName string
+		ID              string
+		OrganizationId  string
+	}
+	mock.lockGetCDNSettings.RLock()
+	calls = mock.calls.GetCDNSettings
+	mock.lockGetCDNSettings.RUnlock()
+	return calls
+}
+
+// GetDeliveryDomain calls GetDeliveryDomainFunc.
+func (mock *APIMock) GetDeliveryDomain(ctx context.Context, environmentName string, id string, organizationId string) (*apiclient.DeliveryDomain, error) {
+	if mock.GetDeliveryDomainFunc == nil {
+		panic("APIMock.GetDeliveryDomainFunc: method is nil but API.GetDeliveryDomain was just called")
+	}
+	callInfo := struct {
+		Ctx             context.Context
+		EnvironmentName string
+		ID              string
+		OrganizationId  string
+	}{
+		Ctx:             ctx,
+		EnvironmentName: environmentName,
+		ID:              id,
+		OrganizationId:  organizationId,
+	}
+	mock.lockGetDeliveryDomain.Lock()
+	mock.calls.GetDeliveryDomain = append(mock.calls.GetDeliveryDomain, callInfo)
+	mock.lockGetDeliveryDomain.Unlock()
+	return mock.GetDeliveryDomainFunc(ctx, environmentName, id, organizationId)
+}
+
+// GetDeliveryDomainCalls gets all the calls that were made to GetDeliveryDomain.
+// Check the length with:
+//
+//	len(mockedAPI.GetDeliveryDomainCalls())
+func (mock *APIMock) GetDeliveryDomainCalls() []struct {
+	Ctx             context.Context
+	EnvironmentName string
+	ID              string
+	OrganizationId  string
+} {
+	var calls []struct {
+		Ctx             context.Context
+		EnvironmentName string
+		ID              string
+		OrganizationId  string
+	}
+	mock.lockGetDeliveryDomain.RLock()
+	calls = mock.calls.GetDeliveryDomain
+	mock.lockGetDeliveryDomain.RUnlock()
+	return calls
+}
+
+// GetDeliveryDomains calls GetDeliveryDomainsFunc.
+func (mock *APIMock) GetDeliveryDomains(ctx context.Context, environmentName string, organizationId string) ([]apiclient.DeliveryDomain, error) {
+	if mock.GetDeliveryDomainsFunc == nil {
+		panic("APIMock.GetDeliveryDomainsFunc: method is nil but API.GetDeliveryDomains was just called")
+	}
+	callInfo := struct {
+		Ctx             context.Context
+		EnvironmentName string
+		OrganizationId  string
+	}{
+		Ctx:             ctx,
+		EnvironmentName: environmentName,
+		OrganizationId:  organizationId,
+	}
+	mock.lockGetDeliveryDomains.Lock()
+	mock.calls.GetDeliveryDomains = append(mock.calls.GetDeliveryDomains, callInfo)
+	mock.lockGetDeliveryDomains.Unlock()
+	return mock.GetDeliveryDomainsFunc(ctx, environmentName, organizationId)
+}
+
+// GetDeliveryDomainsCalls gets all the calls that were made to GetDeliveryDomains.
+// Check the length with:
+//
+//	len(mockedAPI.GetDeliveryDomainsCalls())
+func (mock *APIMock) GetDeliveryDomainsCalls() []struct {
+	Ctx             context.Context
+	EnvironmentName string
+	OrganizationId  string
+} {
+	var calls []struct {
+		Ctx             context.Context
+		EnvironmentName string
+		OrganizationId  string
+	}
+	mock.lockGetDeliveryDomains.RLock()
+	calls = mock.calls.GetDeliveryDomains
+	mock.lockGetDeliveryDomains.RUnlock()
+	return calls
+}
+
+// GetEdgeLogic calls GetEdgeLogicFunc.
+func (mock *APIMock) GetEdgeLogic(ctx context.Context, environmentName string, id string, organizationId string) (*apiclient.EdgeLogic, error) {
+	if mock.GetEdgeLogicFunc == nil {
+		panic("APIMock.GetEdgeLogicFunc: method is nil but API.GetEdgeLogic was just called")
+	}
+	callInfo := struct {
+		Ctx             context.Context
+		EnvironmentName string
+		ID              string
+		OrganizationId  string
+	}{
+		Ctx:             ctx,
+		EnvironmentName: environmentName,
+		ID:              id,
+		OrganizationId:  organizationId,
+	}
+	mock.lockGetEdgeLogic.Lock()
+	mock.calls.GetEdgeLogic = append(mock.calls.GetEdgeLogic, callInfo)
+	mock.lockGetEdgeLogic.Unlock()
+	return mock.GetEdgeLogicFunc(ctx, environmentName, id, organizationId)
+}
+
+// GetEdgeLogicCalls gets all the calls that were made to GetEdgeLogic.
+// Check the length with:
+//
+//	len(mockedAPI.GetEdgeLogicCalls())
+func (mock *APIMock) GetEdgeLogicCalls() []struct {
+	Ctx             context.Context
+	EnvironmentName string
+	ID              string
+	OrganizationId  string
+} {
+	var calls []struct {
+		Ctx             context.Context
+		EnvironmentName string
+		ID              string
+		OrganizationId  string
+	}
+	mock.lockGetEdgeLogic.RLock()
+	calls = mock.calls.GetEdgeLogic
+	mock.lockGetEdgeLogic.RUnlock()
+	return calls
+}
+
+// GetEnvironment calls GetEnvironmentFunc.
+func (mock *APIMock) GetEnvironment(ctx context.Context, id string) (*apiclient.Environment, error) {
+	if mock.GetEnvironmentFunc == nil {
+		panic("APIMock.GetEnvironmentFunc: method is nil but API.GetEnvironment was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  string
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockGetEnvironment.Lock()
+	mock.calls.GetEnvironment = append(mock.calls.GetEnvironment, callInfo)
+	mock.lockGetEnvironment.Unlock()
+	return mock.GetEnvironmentFunc(ctx, id)
+}
+
+// GetEnvironmentCalls gets all the calls that were made to GetEnvironment.
+// Check the length with:
+//
+//	len(mockedAPI.GetEnvironmentCalls())
+func (mock *APIMock) GetEnvironmentCalls() []struct {
+	Ctx context.Context
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  string
+	}
+	mock.lockGetEnvironment.RLock()
+	calls = mock.calls.GetEnvironment
+	mock.lockGetEnvironment.RUnlock()
+	return calls
+}
+
+// GetEnvironments calls GetEnvironmentsFunc.
+func (mock *APIMock) GetEnvironments(ctx context.Context) ([]apiclient.Environment, error) {
+	if mock.GetEnvironmentsFunc == nil {
+		panic("APIMock.GetEnvironmentsFunc: method is nil but API.GetEnvironments was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetEnvironments.Lock()
+	mock.calls.GetEnvironments = append(mock.calls.GetEnvironments, callInfo)
+	mock.lockGetEnvironments.Unlock()
+	return mock.GetEnvironmentsFunc(ctx)
+}
+
+// GetEnvironmentsCalls gets all the calls that were made to GetEnvironments.
+// Check the length with:
+//
+//	len(mockedAPI.GetEnvironmentsCalls())
+func (mock *APIMock) GetEnvironmentsCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockGetEnvironments.RLock()
+	calls = mock.calls.GetEnvironments
+	mock.lockGetEnvironments.RUnlock()
+	return calls
+}
+
+// GetFirewallRule calls GetFirewallRuleFunc.
+func (mock *APIMock) GetFirewallRule(ctx context.Context, environmentName string, siteId string, id string, organizationId string) (*apiclient.FirewallRule, error) {
+	if mock.GetFirewallRuleFunc == nil {
+		panic("APIMock.GetFirewallRuleFunc: method is nil but API.GetFirewallRule was just called")
+	}
+	callInfo := struct {
+		Ctx             context.Context
+		EnvironmentName string
+		SiteId          string
+		ID              string
+		OrganizationId  string
+	}{
+		Ctx:             ctx,
+		EnvironmentName: environmentName,
+		SiteId:          siteId,
+		ID:              id,
+		OrganizationId:  organizationId,
+	}
+	mock.lockGetFirewallRule.Lock()
+	mock.calls.GetFirewallRule = append(mock.calls.GetFirewallRule, callInfo)
+	mock.lockGetFirewallRule.Unlock()
+	return mock.GetFirewallRuleFunc(ctx, environmentName, siteId, id, organizationId)
+}
+
+// GetFirewallRuleCalls gets all the calls that were made to GetFirewallRule.
+// Check the length with:
+//
+//	len(mockedAPI.GetFirewallRuleCalls())
+func (mock *APIMock) GetFirewallRuleCalls() []struct {
+	Ctx             context.Context
+	EnvironmentName string
+	SiteId          string
+	ID              string
+	OrganizationId  string
+} {
+	var calls []struct {
+		Ctx             context.Context
+		EnvironmentName string
+		SiteId          string
+		ID              string
+		OrganizationId  string
+	}
+	mock.lockGetFirewallRule.RLock()
+	calls = mock.calls.GetFirewallRule
+	mock.lockGetFirewallRule.RUnlock()
+	return calls
+}
+
+// GetFirewallRules calls GetFirewallRulesFunc.
+func (mock *APIMock) GetFirewallRules(ctx context.Context, environmentName string, siteId string, organizationId string) ([]apiclient.FirewallRule, error) {
+	if mock.GetFirewallRulesFunc == nil {
+		panic("APIMock.GetFirewallRulesFunc: method is nil but API.GetFirewallRules was just called")
+	}
+	callInfo := struct {
+		Ctx             context.Context
+		EnvironmentName string
+		SiteId          string
+		OrganizationId  string
+	}{
+		Ctx:             ctx,
+		EnvironmentName: environmentName,
+		SiteId:          siteId,
+		OrganizationId:  organizationId,
+	}
+	mock.lockGetFirewallRules.Lock()
+	mock.calls.GetFirewallRules = append(mock.calls.GetFirewallRules, callInfo)
+	mock.lockGetFirewallRules.Unlock()
+	return mock.GetFirewallRulesFunc(ctx, environmentName, siteId, organizationId)
+}
+
+// GetFirewallRulesCalls gets all the calls that were made to GetFirewallRules.
+// Check the length with:
+//
+//	len(mockedAPI.GetFirewallRulesCalls())
+func (mock *APIMock) GetFirewallRulesCalls() []struct {
+	Ctx             context.Context
+	EnvironmentName string
+	SiteId          string
+	OrganizationId  string
+} {
+	var calls []struct {
+		Ctx             context.Context
+		EnvironmentName string
+		SiteId          string
+		OrganizationId  string
+	}
+	mock.lockGetFirewallRules.RLock()
+	calls = mock.calls.GetFirewallRules
+	mock.lockGetFirewallRules.RUnlock()
+	return calls
+}
+
+// GetImage calls GetImageFunc.
+func (mock *APIMock) GetImage(ctx context.Context, environmentName string, id string) (*apiclient.Image, error) {
+	if mock.GetImageFunc == nil {
+		panic("APIMock.GetImageFunc: method is nil but API.GetImage was just called")
+	}
+	callInfo := struct {
+		Ctx             context.Context
+		EnvironmentName string
+		ID              string
+	}{
+		Ctx:             ctx,
+		EnvironmentName: environmentName,
+		ID:              id,
+	}
+	mock.lockGetImage.Lock()
+	mock.calls.GetImage = append(mock.calls.GetImage, callInfo)
+	mock.lockGetImage.Unlock()
+	return mock.GetImageFunc(ctx, environmentName, id)
+}
+
+// GetImageCalls gets all the calls that were made to GetImage.
+// Check the length with:
+//
+//	len(mockedAPI.GetImageCalls())
+func (mock *APIMock) GetImageCalls() []struct {
+	Ctx             context.Context
+	EnvironmentName string
+	ID              string
+} {
+	var calls []struct {
+		Ctx             context.Context
+		EnvironmentName string
+		ID              string
+	}
+	mock.lockGetImage.RLock()
+	calls = mock.calls.GetImage
+	mock.lockGetImage.RUnlock()
+	return calls
+}
+
+// GetImages calls GetImagesFunc.
+func (mock *APIMock) GetImages(ctx context.Context, environmentName string) ([]apiclient.Image, error) {
+	if mock.GetImagesFunc == nil {
+		panic("APIMock.GetImagesFunc: method is nil but API.GetImages was just called")
+	}
+	callInfo := struct {
+		Ctx             context.Context
+		EnvironmentName string
+	}{
+		Ctx:             ctx,
+		EnvironmentName: environmentName,
+	}
+	mock.lockGetImages.Lock()
+	mock.calls.GetImages = append(mock.calls.GetImages, callInfo)
+	mock.lockGetImages.Unlock()
+	return mock.GetImagesFunc(ctx, environmentName)
+}
+
+// GetImagesCalls gets all the calls that were made to GetImages.
+// Check the length with:
+//
+//	len(mockedAPI.GetImagesCalls())
+func (mock *APIMock) GetImagesCalls() []struct {
+	Ctx             context.Context
+	EnvironmentName string
+} {
+	var calls []struct {
+		Ctx             context.Context
+		EnvironmentName string
+	}
+	mock.lockGetImages.RLock()
+	calls = mock.calls.GetImages
+	mock.lockGetImages.RUnlock()
+	return calls
+}
+
+// GetNetworkPolicyRule calls GetNetworkPolicyRuleFunc.
+func (mock *APIMock) GetNetworkPolicyRule(ctx context.Context, environmentName string, id string, organizationId string) (*apiclient.NetworkPolicyRule, error) {
+	if mock.GetNetworkPolicyRuleFunc == nil {
+		panic("APIMock.GetNetworkPolicyRuleFunc: method is nil but API.GetNetworkPolicyRule was just called")
+	}
+	callInfo := struct {
+		Ctx             context.Context
+		EnvironmentName string
+		ID              string
+		OrganizationId  string
+	}{
+		Ctx:             ctx,
+		EnvironmentName: environmentName,
+		ID:              id,
+		OrganizationId:  organizationId,
+	}
+	mock.lockGetNetworkPolicyRule.Lock()
+	mock.calls.GetNetworkPolicyRule = append(mock.calls.GetNetworkPolicyRule, callInfo)
+	mock.lockGetNetworkPolicyRule.Unlock()
+	return mock.GetNetworkPolicyRuleFunc(ctx, environmentName, id, organizationId)
+}
+
+// GetNetworkPolicyRuleCalls gets all the calls that were made to GetNetworkPolicyRule.
+// Check the length with:
+//
+//	len(mockedAPI.GetNetworkPolicyRuleCalls())
+func (mock *APIMock) GetNetworkPolicyRuleCalls() []struct {
+	Ctx             context.Context
+	EnvironmentName string
+	ID              string
+	OrganizationId  string
+} {
+	var calls []struct {
+		Ctx             context.Context
+		EnvironmentName string
+		ID              string
+		OrganizationId  string
+	}
+	mock.lockGetNetworkPolicyRule.RLock()
+	calls = mock.calls.GetNetworkPolicyRule
+	mock.lockGetNetworkPolicyRule.RUnlock()
+	return calls
+}
+
+// GetNetworkPolicyRuleWorkload calls GetNetworkPolicyRuleWorkloadFunc.
+func (mock *APIMock) GetNetworkPolicyRuleWorkload(ctx context.Context, environmentName string, id string, organizationId string) ([]apiclient.NetworkPolicyRule, error) {
+	if mock.GetNetworkPolicyRuleWorkloadFunc == nil {
+		panic("APIMock.GetNetworkPolicyRuleWorkloadFunc: method is nil but API.GetNetworkPolicyRuleWorkload was just called")
+	}
+	callInfo := struct {
+		Ctx             context.Context
+		EnvironmentName string
+		ID              string
+		OrganizationId  string
+	}{
+		Ctx:             ctx,
+		EnvironmentName: environmentName,
+		ID:              id,
+		OrganizationId:  organizationId,
+	}
+	mock.lockGetNetworkPolicyRuleWorkload.Lock()
+	mock.calls.GetNetworkPolicyRuleWorkload = append(mock.calls.GetNetworkPolicyRuleWorkload, callInfo)
+	mock.lockGetNetworkPolicyRuleWorkload.Unlock()
+	return mock.GetNetworkPolicyRuleWorkloadFunc(ctx, environmentName, id, organizationId)
+}
+
+// GetNetworkPolicyRuleWorkloadCalls gets all the calls that were made to GetNetworkPolicyRuleWorkload.
+// Check the length with:
+//
+//	len(mockedAPI.GetNetworkPolicyRuleWorkloadCalls())
+func (mock *APIMock) GetNetworkPolicyRuleWorkloadCalls() []struct {
+	Ctx             context.Context
+	EnvironmentName string
+	ID              string
+	OrganizationId  string
+} {
+	var calls []struct {
+		Ctx             context.Context
+		EnvironmentName string
+		ID              string
+		OrganizationId  string
+	}
+	mock.lockGetNetworkPolicyRuleWorkload.RLock()
+	calls = mock.calls.GetNetworkPolicyRuleWorkload
+	mock.lockGetNetworkPolicyRuleWorkload.RUnlock()
+	return calls
+}
+
+// GetNetworkPolicyRules calls GetNetworkPolicyRulesFunc.
+func (mock *APIMock) GetNetworkPolicyRules(ctx context.Context, environmentName string, organizationId string) ([]apiclient.NetworkPolicyRule, error) {
+	if mock.GetNetworkPolicyRulesFunc == nil {
+		panic("APIMock.GetNetworkPolicyRulesFunc: method is nil but API.GetNetworkPolicyRules was just called")
+	}
+	callInfo := struct {
+		Ctx             context.Context
+		EnvironmentName string
+		OrganizationId  string
+	}{
+		Ctx:             ctx,
+		EnvironmentName: environmentName,
+		OrganizationId:  organizationId,
+	}
+	mock.lockGetNetworkPolicyRules.Lock()
+	mock.calls.GetNetworkPolicyRules = append(mock.calls.GetNetworkPolicyRules, callInfo)
+	mock.lockGetNetworkPolicyRules.Unlock()
+	return mock.GetNetworkPolicyRulesFunc(ctx, environmentName, organizationId)
+}
+
+// GetNetworkPolicyRulesCalls gets all the calls that were made to GetNetworkPolicyRules.
+// Check the length with:
+//
+//	len(mockedAPI.GetNetworkPolicyRulesCalls())
+func (mock *APIMock) GetNetworkPolicyRulesCalls() []struct {
+	Ctx             context.Context
+	EnvironmentName string
+	OrganizationId  string
+} {
+	var calls []struct {
+		Ctx             context.Context
+		EnvironmentName string
+		OrganizationId  string
+	}
+	mock.lockGetNetworkPolicyRules.RLock()
+	calls = mock.calls.GetNetworkPolicyRules
+	mock.lockGetNetworkPolicyRules.RUnlock()
+	return calls
+}
+
+// GetOrganization calls GetOrganizationFunc.
+func (mock *APIMock) GetOrganization(ctx context.Context, id string) (*apiclient.Organization, error) {
+	if mock.GetOrganizationFunc == nil {
+		panic("APIMock.GetOrganizationFunc: method is nil but API.GetOrganization was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  string
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockGetOrganization.Lock()
+	mock.calls.GetOrganization = append(mock.calls.GetOrganization, callInfo)
+	mock.lockGetOrganization.Unlock()
+	return mock.GetOrganizationFunc(ctx, id)
+}
+
+// GetOrganizationCalls gets all the calls that were made to GetOrganization.
+// Check the length with:
+//
+//	len(mockedAPI.GetOrganizationCalls())
+func (mock *APIMock) GetOrganizationCalls() []struct {
+	Ctx context.Context
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  string
+	}
+	mock.lockGetOrganization.RLock()
+	calls = mock.calls.GetOrganization
+	mock.lockGetOrganization.RUnlock()
+	return calls
+}
+
+// GetOrganizationBillingInfo calls GetOrganizationBillingInfoFunc.
+func (mock *APIMock) GetOrganizationBillingInfo(ctx context.Context, id string) (*apiclient.OrganizationBillingInfo, error) {
+	if mock.GetOrganizationBillingInfoFunc == nil {
+		panic("APIMock.GetOrganizationBillingInfoFunc: method is nil but API.GetOrganizationBillingInfo was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  string
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockGetOrganizationBillingInfo.Lock()
+	mock.calls.GetOrganizationBillingInfo = append(mock.calls.GetOrganizationBillingInfo, callInfo)
+	mock.lockGetOrganizationBillingInfo.Unlock()
+	return mock.GetOrganizationBillingInfoFunc(ctx, id)
+}
+
+// GetOrganizationBillingInfoCalls gets all the calls that were made to GetOrganizationBillingInfo.
+// Check the length with:
+//
+//	len(mockedAPI.GetOrganizationBillingInfoCalls())
+func (mock *APIMock) GetOrganizationBillingInfoCalls() []struct {
+	Ctx context.Context
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  string
+	}
+	mock.lockGetOrganizationBillingInfo.RLock()
+	calls = mock.calls.GetOrganizationBillingInfo
+	mock.lockGetOrganizationBillingInfo.RUnlock()
+	return calls
+}
+
+// GetOrganizations calls GetOrganizationsFunc.
+func (mock *APIMock) GetOrganizations(ctx context.Context) ([]apiclient.Organization, error) {
+	if mock.GetOrganizationsFunc == nil {
+		panic("APIMock.GetOrganizationsFunc: method is nil but API.GetOrganizations was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetOrganizations.Lock()
+	mock.calls.GetOrganizations = append(mock.calls.GetOrganizations, callInfo)
+	mock.lockGetOrganizations.Unlock()
+	return mock.GetOrganizationsFunc(ctx)
+}
+
+// GetOrganizationsCalls gets all the calls that were made to GetOrganizations.
+// Check the length with:
+//
+//	len(mockedAPI.GetOrganizationsCalls())
+func (mock *APIMock) GetOrganizationsCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockGetOrganizations.RLock()
+	calls = mock.calls.GetOrganizations
+	mock.lockGetOrganizations.RUnlock()
+	return calls
+}
+
+// GetOriginSettings calls GetOriginSettingsFunc.
+func (mock *APIMock) GetOriginSettings(ctx context.Context, environmentName string, id string, organizationId string) (*apiclient.OriginSettings, error) {
+	if mock.GetOriginSettingsFunc == nil {
+		panic("APIMock.GetOriginSettingsFunc: method is nil but API.GetOriginSettings was just called")
+	}
+	callInfo := struct {
+		Ctx             context.Context
+		EnvironmentName string
+		ID              string
+		OrganizationId  string
+	}{
+		Ctx:             ctx,
+		EnvironmentName: environmentName,
+		ID:              id,
+		OrganizationId:  organizationId,
+	}
+	mock.lockGetOriginSettings.Lock()
+	mock.calls.GetOriginSettings = append(mock.calls.GetOriginSettings, callInfo)
+	mock.lockGetOriginSettings.Unlock()
+	return mock.GetOriginSettingsFunc(ctx, environmentName, id, organizationId)
+}
+
+// GetOriginSettingsCalls gets all the calls that were made to GetOriginSettings.
+// Check the length with:
+//
+//	len(mockedAPI.GetOriginSettingsCalls())
+func (mock *APIMock) GetOriginSettingsCalls() []struct {
+	Ctx             context.Context
+	EnvironmentName string
+	ID              string
+	OrganizationId  string
+} {
+	var calls []struct {
+		Ctx             context.Context
+		EnvironmentName string
+		ID              string
+		OrganizationId  string
+	}
+	mock.lockGetOriginSettings.RLock()
+	calls = mock.calls.GetOriginSettings
+	mock.lockGetOriginSettings.RUnlock()
+	return calls
+}
+
+// GetRole calls GetRoleFunc.
+func (mock *APIMock) GetRole(ctx context.Context, id string) (*apiclient.Roles, error) {
+	if mock.GetRoleFunc == nil {
+		panic("APIMock.GetRoleFunc: method is nil but API.GetRole was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  string
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockGetRole.Lock()
+	mock.calls.GetRole = append(mock.calls.GetRole, callInfo)
+	mock.lockGetRole.Unlock()
+	return mock.GetRoleFunc(ctx, id)
+}
+
+// GetRoleCalls gets all the calls that were made to GetRole.
+// Check the length with:
+//
+//	len(mockedAPI.GetRoleCalls())
+func (mock *APIMock) GetRoleCalls() []struct {
+	Ctx context.Context
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  string
+	}
+	mock.lockGetRole.RLock()
+	calls = mock.calls.GetRole
+	mock.lockGetRole.RUnlock()
+	return calls
+}
+
+// GetRoles calls GetRolesFunc.
+func (mock *APIMock) GetRoles(ctx context.Context) ([]apiclient.Roles, error) {
+	if mock.GetRolesFunc == nil {
+		panic("APIMock.GetRolesFunc: method is nil but API.GetRoles was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetRoles.Lock()
+	mock.calls.GetRoles = append(mock.calls.GetRoles, callInfo)
+	mock.lockGetRoles.Unlock()
+	return mock.GetRolesFunc(ctx)
+}
+
+// GetRolesCalls gets all the calls that were made to GetRoles.
+// Check the length with:
+//
+//	len(mockedAPI.GetRolesCalls())
+func (mock *APIMock) GetRolesCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockGetRoles.RLock()
+	calls = mock.calls.GetRoles
+	mock.lockGetRoles.RUnlock()
+	return calls
+}
+
+// GetScript calls GetScriptFunc.
+func (mock *APIMock) GetScript(ctx context.Context, id string, siteId string, environmentName string, organizationId string) (*apiclient.Script, error) {
+	if mock.GetScriptFunc == nil {
+		panic("APIMock.GetScriptFunc: method is nil but API.GetScript was just called")
+	}
+	callInfo := struct {
+		Ctx             context.Context
+		ID              string
+		SiteId          string
+		EnvironmentName string
+		OrganizationId  string
+	}{
+		Ctx:             ctx,
+		ID:              id,
+		SiteId:          siteId,
+		EnvironmentName: environmentName,
+		OrganizationId:  organizationId,
+	}
+	mock.lockGetScript.Lock()
+	mock.calls.GetScript = append(mock.calls.GetScript, callInfo)
+	mock.lockGetScript.Unlock()
+	return mock.GetScriptFunc(ctx, id, siteId, environmentName, organizationId)
+}
+
+// GetScriptCalls gets all the calls that were made to GetScript.
+// Check the length with:
+//
+//	len(mockedAPI.GetScriptCalls())
+func (mock *APIMock) GetScriptCalls() []struct {
+	Ctx             context.Context
+	ID              string
+	SiteId          string
+	EnvironmentName string
+	OrganizationId  string
+} {
+	var calls []struct {
+		Ctx             context.Context
+		ID              string
+		SiteId          string
+		EnvironmentName string
+		OrganizationId  string
+	}
+	mock.lockGetScript.RLock()
+	calls = mock.calls.GetScript
+	mock.lockGetScript.RUnlock()
+	return calls
+}
+
+// GetScripts calls GetScriptsFunc.
+func (mock *APIMock) GetScripts(ctx context.Context, siteId string, environmentName string, organizationId string) ([]apiclient.Script, error) {
+	if mock.GetScriptsFunc == nil {
+		panic("APIMock.GetScriptsFunc: method is nil but API.GetScripts was just called")
+	}
+	callInfo := struct {
+		Ctx             context.Context
+		SiteId          string
+		EnvironmentName string
+		OrganizationId  string
+	}{
+		Ctx:             ctx,
+		SiteId:          siteId,
+		EnvironmentName: environmentName,
+		OrganizationId:  organizationId,
+	}
+	mock.lockGetScripts.Lock()
+	mock.calls.GetScripts = append(mock.calls.GetScripts, callInfo)
+	mock.lockGetScripts.Unlock()
+	return mock.GetScriptsFunc(ctx, siteId, environmentName, organizationId)
+}
+
+// GetScriptsCalls gets all the calls that were made to GetScripts.
+// Check the length with:
+//
+//	len(mockedAPI.GetScriptsCalls())
+func (mock *APIMock) GetScriptsCalls() []struct {
+	Ctx             context.Context
+	SiteId          string
+	EnvironmentName string
+	OrganizationId  string
+} {
+	var calls []struct {
+		Ctx             context.Context
+		SiteId          string
+		EnvironmentName string
+		OrganizationId  string
+	}
+	mock.lockGetScripts.RLock()
+	calls = mock.calls.GetScripts
+	mock.lockGetScripts.RUnlock()
+	return calls
+}
+
+// GetSite calls GetSiteFunc.
+func (mock *APIMock) GetSite(ctx context.Context, environmentName string, id string, organizationId string) (*apiclient.Site, error) {
+	if mock.GetSiteFunc == nil {
+		panic("APIMock.GetSiteFunc: method is nil but API.GetSite was just called")
+	}
+	callInfo := struct {
+		Ctx             context.Context
+		EnvironmentName string
+		ID              string
+		OrganizationId  string
+	}{
+		Ctx:             ctx,
+		EnvironmentName: environmentName,
+		ID:              id,
+		OrganizationId:  organizationId,
+	}
+	mock.lockGetSite.Lock()
+	mock.calls.GetSite = append(mock.calls.GetSite, callInfo)
+	mock.lockGetSite.Unlock()
+	return mock.GetSiteFunc(ctx, environmentName, id, organizationId)
+}
+
+// GetSiteCalls gets all the calls that were made to GetSite.
+// Check the length with:
+//
+//	len(mockedAPI.GetSiteCalls())
+func (mock *APIMock) GetSiteCalls() []struct {
+	Ctx             context.Context
+	EnvironmentName string
+	ID              string
+	OrganizationId  string
+} {
+	var calls []struct {
+		Ctx             context.Context
+		EnvironmentName string
+		ID              string
+		OrganizationId  string
+	}
+	mock.lockGetSite.RLock()
+	calls = mock.calls.GetSite
+	mock.lockGetSite.RUnlock()
+	return calls
+}
+
+// GetSites calls GetSitesFunc.
+func (mock *APIMock) GetSites(ctx context.Context, environmentName string, organizationId string) ([]apiclient.Site, error) {
+	if mock.GetSitesFunc == nil {
+		panic("APIMock.GetSitesFunc: method is nil but API.GetSites was just called")
+	}
+	callInfo := struct {
+		Ctx             context.Context
+		EnvironmentName string
+		OrganizationId  string
+	}{
+		Ctx:             ctx,
+		EnvironmentName: environmentName,
+		OrganizationId:  organizationId,
+	}
+	mock.lockGetSites.Lock()
+	mock.calls.GetSites = append(mock.calls.GetSites, callInfo)
+	mock.lockGetSites.Unlock()
+	return mock.GetSitesFunc(ctx, environmentName, organizationId)
+}
+
+// GetSitesCalls gets all the calls that were made to GetSites.
+// Check the length with:
+//
+//	len(mockedAPI.GetSitesCalls())
+func (mock *APIMock) GetSitesCalls() []struct {
+	Ctx             context.Context
+	EnvironmentName string
+	OrganizationId  string
+} {
+	var calls []struct {
+		Ctx             context.Context
+		EnvironmentName string
+		OrganizationId  string
+	}
+	mock.lockGetSites.RLock()
+	calls = mock.calls.GetSites
+	mock.lockGetSites.RUnlock()
+	return calls
+}
+
+// GetTaskStatus calls GetTaskStatusFunc.
+func (mock *APIMock) GetTaskStatus(ctx context.Context, taskId string) (*apiclient.TaskStatus, error) {
+	if mock.GetTaskStatusFunc == nil {
+		panic("APIMock.GetTaskStatusFunc: method is nil but API.GetTaskStatus was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		TaskId string
+	}{
+		Ctx:    ctx,
+		TaskId: taskId,
+	}
+	mock.lockGetTaskStatus.Lock()
+	mock.calls.GetTaskStatus = append(mock.calls.GetTaskStatus, callInfo)
+	mock.lockGetTaskStatus.Unlock()
+	return mock.GetTaskStatusFunc(ctx, taskId)
+}
+
+// GetTaskStatusCalls gets all the calls that were made to GetTaskStatus.
+// Check the length with:
+//
+//	len(mockedAPI.GetTaskStatusCalls())
+func (mock *APIMock) GetTaskStatusCalls() []struct {
+	Ctx    context.Context
+	TaskId string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		TaskId string
+	}
+	mock.lockGetTaskStatus.RLock()
+	calls = mock.calls.GetTaskStatus
+	mock.lockGetTaskStatus.RUnlock()
+	return calls
+}
+
+// GetUser calls GetUserFunc.
+func (mock *APIMock) GetUser(ctx context.Context, id string) (*apiclient.User, error) {
+	if mock.GetUserFunc == nil {
+		panic("APIMock.GetUserFunc: method is nil but API.GetUser was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  string
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockGetUser.Lock()
+	mock.calls.GetUser = append(mock.calls.GetUser, callInfo)
+	mock.lockGetUser.Unlock()
+	return mock.GetUserFunc(ctx, id)
+}
+
+// GetUserCalls gets all the calls that were made to GetUser.
+// Check the length with:
+//
+//	len(mockedAPI.GetUserCalls())
+func (mock *APIMock) GetUserCalls() []struct {
+	Ctx context.Context
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  string
+	}
+	mock.lockGetUser.RLock()
+	calls = mock.calls.GetUser
+	mock.lockGetUser.RUnlock()
+	return calls
+}
+
+// GetUsers calls GetUsersFunc.
+func (mock *APIMock) GetUsers(ctx context.Context) ([]apiclient.User, error) {
+	if mock.GetUsersFunc == nil {
+		panic("APIMock.GetUsersFunc: method is nil but API.GetUsers was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetUsers.Lock()
+	mock.calls.GetUsers = append(mock.calls.GetUsers, callInfo)
+	mock.lockGetUsers.Unlock()
+	return mock.GetUsersFunc(ctx)
+}
+
+// GetUsersCalls gets all the calls that were made to GetUsers.
+// Check the length with:
+//
+//	len(mockedAPI.GetUsersCalls())
+func (mock *APIMock) GetUsersCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockGetUsers.RLock()
+	calls = mock.calls.GetUsers
+	mock.lockGetUsers.RUnlock()
+	return calls
+}
+
+// GetWAFSettings calls GetWAFSettingsFunc.
+func (mock *APIMock) GetWAFSettings(ctx context.Context, environmentName string, id string, organizationId string) (*apiclient.WAFSettings, error) {
+	if mock.GetWAFSettingsFunc == nil {
+		panic("APIMock.GetWAFSettingsFunc: method is nil but API.GetWAFSettings was just called")
+	}
+	callInfo := struct {
+		Ctx             context.Context
+		EnvironmentName string
+		ID              string
+		OrganizationId  string
+	}{
+		Ctx:             ctx,
+		EnvironmentName: environmentName,
+		ID:              id,
+		OrganizationId:  organizationId,
+	}
+	mock.lockGetWAFSettings.Lock()
+	mock.calls.GetWAFSettings = append(mock.calls.GetWAFSettings, callInfo)
+	mock.lockGetWAFSettings.Unlock()
+	return mock.GetWAFSettingsFunc(ctx, environmentName, id, organizationId)
+}
+
+// GetWAFSettingsCalls gets all the calls that were made to GetWAFSettings.
+// Check the length with:
+//
+//	len(mockedAPI.GetWAFSettingsCalls())
+func (mock *APIMock) GetWAFSettingsCalls() []struct {
+	Ctx             context.Context
+	EnvironmentName string
+	ID              string
+	OrganizationId  string
+} {
+	var calls []struct {
+		Ctx             context.Context
+		EnvironmentName string
+		ID              string
+		OrganizationId  string
+	}
+	mock.lockGetWAFSettings.RLock()
+	calls = mock.calls.GetWAFSettings
+	mock.lockGetWAFSettings.RUnlock()
+	return calls
+}
+
+// GetWorkload calls GetWorkloadFunc.
+func (mock *APIMock) GetWorkload(ctx context.Context, environmentName string, id string, organizationId string) (*apiclient.Workload, error) {
+	if mock.GetWorkloadFunc == nil {
+		panic("APIMock.GetWorkloadFunc: method is nil but API.GetWorkload was just called")
+	}
+	callInfo := struct {
+		Ctx             context.Context
+		EnvironmentName string
+		ID              string
+		OrganizationId  string
+	}{
+		Ctx:             ctx,
+		EnvironmentName: environmentName,
+		ID:              id,
+		OrganizationId:  organizationId,
+	}
+	mock.lockGetWorkload.Lock()
+	mock.calls.GetWorkload = append(mock.calls.GetWorkload, callInfo)
+	mock.lockGetWorkload.Unlock()
+	return mock.GetWorkloadFunc(ctx, environmentName, id, organizationId)
+}
+
+// GetWorkloadCalls gets all the calls that were made to GetWorkload.
+// Check the length with:
+//
+//	len(mockedAPI.GetWorkloadCalls())
+func (mock *APIMock) GetWorkloadCalls() []struct {
+	Ctx             context.Context
+	EnvironmentName string
+	ID              string
+	OrganizationId  string
+} {
+	var calls []struct {
+		Ctx             context.Context
+		EnvironmentName string
+		ID              string
+		OrganizationId  string
+	}
+	mock.lockGetWorkload.RLock()
+	calls = mock.calls.GetWorkload
+	mock.lockGetWorkload.RUnlock()
+	return calls
+}
+
+// GetWorkloadInstances calls GetWorkloadInstancesFunc.
+func (mock *APIMock) GetWorkloadInstances(ctx context.Context, environmentName string, organizationId string, workloadId string) ([]apiclient.WorkloadInstance, error) {
+	if mock.GetWorkloadInstancesFunc == nil {
+		panic("APIMock.GetWorkloadInstancesFunc: method is nil but API.GetWorkloadInstances was just called")
+	}
+	callInfo := struct {
+		Ctx             context.Context
+		EnvironmentName string
+		OrganizationId  string
+		WorkloadId      string
+	}{
+		Ctx:             ctx,
+		EnvironmentName: environmentName,
+		OrganizationId:  organizationId,
+		WorkloadId:      workloadId,
+	}
+	mock.lockGetWorkloadInstances.Lock()
+	mock.calls.GetWorkloadInstances = append(mock.calls.GetWorkloadInstances, callInfo)
+	mock.lockGetWorkloadInstances.Unlock()
+	return mock.GetWorkloadInstancesFunc(ctx, environmentName, organizationId, workloadId)
+}
+
+// GetWorkloadInstancesCalls gets all the calls that were made to GetWorkloadInstances.
+// Check the length with:
+//
+//	len(mockedAPI.GetWorkloadInstancesCalls())
+func (mock *APIMock) GetWorkloadInstancesCalls() []struct {
+	Ctx             context.Context
+	EnvironmentName string
+	OrganizationId  string
+	WorkloadId      string
+} {
+	var calls []struct {
+		Ctx             context.Context
+		EnvironmentName string
+		OrganizationId  string
+		WorkloadId      string
+	}
+	mock.lockGetWorkloadInstances.RLock()
+	calls = mock.calls.GetWorkloadInstances
+	mock.lockGetWorkloadInstances.RUnlock()
+	return calls
+}
+
+// GetWorkloads calls GetWorkloadsFunc.
+func (mock *APIMock) GetWorkloads(ctx context.Context, environmentName string, organizationId string) ([]apiclient.Workload, error) {
+	if mock.GetWorkloadsFunc == nil {
+		panic("APIMock.GetWorkloadsFunc: method is nil but API.GetWorkloads was just called")
+	}
+	callInfo := struct {
+		Ctx             context.Context
+		EnvironmentName string
+		OrganizationId  string
+	}{
+		Ctx:             ctx,
+		EnvironmentName: environmentName,
+		OrganizationId:  organizationId,
+	}
+	mock.lockGetWorkloads.Lock()
+	mock.calls.GetWorkloads = append(mock.calls.GetWorkloads, callInfo)
+	mock.lockGetWorkloads.Unlock()
+	return mock.GetWorkloadsFunc(ctx, environmentName, organizationId)
+}
+
+// GetWorkloadsCalls gets all the calls that were made to GetWorkloads.
+// Check the length with:
+//
+//	len(mockedAPI.GetWorkloadsCalls())
+func (mock *APIMock) GetWorkloadsCalls() []struct {
+	Ctx             context.Context
+	EnvironmentName string
+	OrganizationId  string
+} {
+	var calls []struct {
+		Ctx             context.Context
+		EnvironmentName string
+		OrganizationId  string
+	}
+	mock.lockGetWorkloads.RLock()
+	calls = mock.calls.GetWorkloads
+	mock.lockGetWorkloads.RUnlock()
+	return calls
+}
+
+// PurgeCDN calls PurgeCDNFunc.
+func (mock *APIMock) PurgeCDN(ctx context.Context, environmentName string, siteId string, options apiclient.CDNPurgeOptions, organizationId string) (*apiclient.TaskStatusResponse, error) {
+	if mock.PurgeCDNFunc == nil {
+		panic("APIMock.PurgeCDNFunc: method is nil but API.PurgeCDN was just called")
+	}
+	callInfo := struct {
+		Ctx             context.Context
+		EnvironmentName string
+		SiteId          string
+		Options         apiclient.CDNPurgeOptions
+		OrganizationId  string
+	}{
+		Ctx:             ctx,
+		EnvironmentName: environmentName,
+		SiteId:          siteId,
+		Options:         options,
+		OrganizationId:  organizationId,
+	}
+	mock.lockPurgeCDN.Lock()
+	mock.calls.PurgeCDN = append(mock.calls.PurgeCDN, callInfo)
+	mock.lockPurgeCDN.Unlock()
+	return mock.PurgeCDNFunc(ctx, environmentName, siteId, options, organizationId)
+}
+
+// PurgeCDNCalls gets all the calls that were made to PurgeCDN.
+// Check the length with:
+//
+//	len(mockedAPI.PurgeCDNCalls())
+func (mock *APIMock) PurgeCDNCalls() []struct {
+	Ctx             context.Context
+	EnvironmentName string
+	SiteId          string
+	Options         apiclient.CDNPurgeOptions
+	OrganizationId  string
+} {
+	var calls []struct {
+		Ctx             context.Context
+		EnvironmentName string
+		SiteId          string
+		Options         apiclient.CDNPurgeOptions
+		OrganizationId  string
+	}
+	mock.lockPurgeCDN.RLock()
+	calls = mock.calls.PurgeCDN
+	mock.lockPurgeCDN.RUnlock()
+	return calls
+}
+
+// UnlockUser calls UnlockUserFunc.
+func (mock *APIMock) UnlockUser(ctx context.Context, id string) error {
+	if mock.UnlockUserFunc == nil {
+		panic("APIMock.UnlockUserFunc: method is nil but API.UnlockUser was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  string
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockUnlockUser.Lock()
+	mock.calls.UnlockUser = append(mock.calls.UnlockUser, callInfo)
+	mock.lockUnlockUser.Unlock()
+	return mock.UnlockUserFunc(ctx, id)
+}
+
+// UnlockUserCalls gets all the calls that were made to UnlockUser.
+// Check the length with:
+//
+//	len(mockedAPI.UnlockUserCalls())
+func (mock *APIMock) UnlockUserCalls() []struct {
+	Ctx context.Context
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  string
+	}
+	mock.lockUnlockUser.RLock()
+	calls = mock.calls.UnlockUser
+	mock.lockUnlockUser.RUnlock()
+	return calls
+}
+
+// UpdateCDNSettings calls UpdateCDNSettingsFunc.
+func (mock *APIMock) UpdateCDNSettings(ctx context.Context, cdnSettingsId string, newCDNSettings apiclient.CDNSettings, organizationId string) (*apiclient.TaskStatusResponse, error) {
+	if mock.UpdateCDNSettingsFunc == nil {
+		panic("APIMock.UpdateCDNSettingsFunc: method is nil but API.UpdateCDNSettings was just called")
+	}
+	callInfo := struct {
+		Ctx            context.Context
+		CdnSettingsId  string
+		NewCDNSettings apiclient.CDNSettings
+		OrganizationId string
+	}{
+		Ctx:            ctx,
+		CdnSettingsId:  cdnSettingsId,
+		NewCDNSettings: newCDNSettings,
+		OrganizationId: organizationId,
+	}
+	mock.lockUpdateCDNSettings.Lock()
+	mock.calls.UpdateCDNSettings = append(mock.calls.UpdateCDNSettings, callInfo)
+	mock.lockUpdateCDNSettings.Unlock()
+	return mock.UpdateCDNSettingsFunc(ctx, cdnSettingsId, newCDNSettings, organizationId)
+}
+
+// UpdateCDNSettingsCalls gets all the calls that were made to UpdateCDNSettings.
+// Check the length with:
+//
+//	len(mockedAPI.UpdateCDNSettingsCalls())
+func (mock *APIMock) UpdateCDNSettingsCalls() []struct {
+	Ctx            context.Context
+	CdnSettingsId  string
+	NewCDNSettings apiclient.CDNSettings
+	OrganizationId string
+} {
+	var calls []struct {
+		Ctx            context.Context
+		CdnSettingsId  string
+		NewCDNSettings apiclient.CDNSettings
+		OrganizationId string
+	}
+	mock.lockUpdateCDNSettings.RLock()
+	calls = mock.calls.UpdateCDNSettings
+	mock.lockUpdateCDNSettings.RUnlock()
+	return calls
+}
+
+// UpdateEdgeLogic calls UpdateEdgeLogicFunc.
+func (mock *APIMock) UpdateEdgeLogic(ctx context.Context, edgeLogicId string, newEdgeLogic apiclient.EdgeLogic, organizationId string) (*apiclient.TaskStatusResponse, error) {
+	if mock.UpdateEdgeLogicFunc == nil {
+		panic("APIMock.UpdateEdgeLogicFunc: method is nil but API.UpdateEdgeLogic was just called")
+	}
+	callInfo := struct {
+		Ctx            context.Context
+		EdgeLogicId    string
+		NewEdgeLogic   apiclient.EdgeLogic
+		OrganizationId string
+	}{
+		Ctx:            ctx,
+		EdgeLogicId:    edgeLogicId,
+		NewEdgeLogic:   newEdgeLogic,
+		OrganizationId: organizationId,
+	}
+	mock.lockUpdateEdgeLogic.Lock()
+	mock.calls.UpdateEdgeLogic = append(mock.calls.UpdateEdgeLogic, callInfo)
+	mock.lockUpdateEdgeLogic.Unlock()
+	return mock.UpdateEdgeLogicFunc(ctx, edgeLogicId, newEdgeLogic, organizationId)
+}
+
+// UpdateEdgeLogicCalls gets all the calls that were made to UpdateEdgeLogic.
+// Check the length with:
+//
+//	len(mockedAPI.UpdateEdgeLogicCalls())
+func (mock *APIMock) UpdateEdgeLogicCalls() []struct {
+	Ctx            context.Context
+	EdgeLogicId    string
+	NewEdgeLogic   apiclient.EdgeLogic
+	OrganizationId string
+} {
+	var calls []struct {
+		Ctx            context.Context
+		EdgeLogicId    string
+		NewEdgeLogic   apiclient.EdgeLogic
+		OrganizationId string
+	}
+	mock.lockUpdateEdgeLogic.RLock()
+	calls = mock.calls.UpdateEdgeLogic
+	mock.lockUpdateEdgeLogic.RUnlock()
+	return calls
+}
+
+// UpdateEnvironment calls UpdateEnvironmentFunc.
+func (mock *APIMock) UpdateEnvironment(ctx context.Context, EnvironmentId string, newEnvironment apiclient.EnvironmentCreateRequest) (*apiclient.Environment, error) {
+	if mock.UpdateEnvironmentFunc == nil {
+		panic("APIMock.UpdateEnvironmentFunc: method is nil but API.UpdateEnvironment was just called")
+	}
+	callInfo := struct {
+		Ctx            context.Context
+		EnvironmentId  string
+		NewEnvironment apiclient.EnvironmentCreateRequest
+	}{
+		Ctx:            ctx,
+		EnvironmentId:  EnvironmentId,
+		NewEnvironment: newEnvironment,
+	}
+	mock.lockUpdateEnvironment.Lock()
+	mock.calls.UpdateEnvironment = append(mock.calls.UpdateEnvironment, callInfo)
+	mock.lockUpdateEnvironment.Unlock()
+	return mock.UpdateEnvironmentFunc(ctx, EnvironmentId, newEnvironment)
+}
+
+// UpdateEnvironmentCalls gets all the calls that were made to UpdateEnvironment.
+// Check the length with:
+//
+//	len(mockedAPI.UpdateEnvironmentCalls())
+func (mock *APIMock) UpdateEnvironmentCalls() []struct {
+	Ctx            context.Context
+	EnvironmentId  string
+	NewEnvironment apiclient.EnvironmentCreateRequest
+} {
+	var calls []struct {
+		Ctx            context.Context
+		EnvironmentId  string
+		NewEnvironment apiclient.EnvironmentCreateRequest
+	}
+	mock.lockUpdateEnvironment.RLock()
+	calls = mock.calls.UpdateEnvironment
+	mock.lockUpdateEnvironment.RUnlock()
+	return calls
+}
+
+// UpdateEnvironmentMember calls UpdateEnvironmentMemberFunc.
+func (mock *APIMock) UpdateEnvironmentMember(ctx context.Context, EnvironmentId string, newEnvironment apiclient.EnvironmentMembersRequest) (*apiclient.Environment, error) {
+	if mock.UpdateEnvironmentMemberFunc == nil {
+		panic("APIMock.UpdateEnvironmentMemberFunc: method is nil but API.UpdateEnvironmentMember was just called")
+	}
+	callInfo := struct {
+		Ctx            context.Context
+		EnvironmentId  string
+		NewEnvironment apiclient.EnvironmentMembersRequest
+	}{
+		Ctx:            ctx,
+		EnvironmentId:  EnvironmentId,
+		NewEnvironment: newEnvironment,
+	}
+	mock.lockUpdateEnvironmentMember.Lock()
+	mock.calls.UpdateEnvironmentMember = append(mock.calls.UpdateEnvironmentMember, callInfo)
+	mock.lockUpdateEnvironmentMember.Unlock()
+	return mock.UpdateEnvironmentMemberFunc(ctx, EnvironmentId, newEnvironment)
+}
+
+// UpdateEnvironmentMemberCalls gets all the calls that were made to UpdateEnvironmentMember.
+// Check the length with:
+//
+//	len(mockedAPI.UpdateEnvironmentMemberCalls())
+func (mock *APIMock) UpdateEnvironmentMemberCalls() []struct {
+	Ctx            context.Context
+	EnvironmentId  string
+	NewEnvironment apiclient.EnvironmentMembersRequest
+} {
+	var calls []struct {
+		Ctx            context.Context
+		EnvironmentId  string
+		NewEnvironment apiclient.EnvironmentMembersRequest
+	}
+	mock.lockUpdateEnvironmentMember.RLock()
+	calls = mock.calls.UpdateEnvironmentMember
+	mock.lockUpdateEnvironmentMember.RUnlock()
+	return calls
+}
+
+// UpdateEnvironmentMembership calls UpdateEnvironmentMembershipFunc.
+func (mock *APIMock) UpdateEnvironmentMembership(ctx context.Context, EnvironmentId string, newEnvironment apiclient.EnvironmentMembershipRequest) (*apiclient.Environment, error) {
+	if mock.UpdateEnvironmentMembershipFunc == nil {
+		panic("APIMock.UpdateEnvironmentMembershipFunc: method is nil but API.UpdateEnvironmentMembership was just called")
+	}
+	callInfo := struct {
+		Ctx            context.Context
+		EnvironmentId  string
+		NewEnvironment apiclient.EnvironmentMembershipRequest
+	}{
+		Ctx:            ctx,
+		EnvironmentId:  EnvironmentId,
+		NewEnvironment: newEnvironment,
+	}
+	mock.lockUpdateEnvironmentMembership.Lock()
+	mock.calls.UpdateEnvironmentMembership = append(mock.calls.UpdateEnvironmentMembership, callInfo)
+	mock.lockUpdateEnvironmentMembership.Unlock()
+	return mock.UpdateEnvironmentMembershipFunc(ctx, EnvironmentId, newEnvironment)
+}
+
+// UpdateEnvironmentMembershipCalls gets all the calls that were made to UpdateEnvironmentMembership.
+// Check the length with:
+//
+//	len(mockedAPI.UpdateEnvironmentMembershipCalls())
+func (mock *APIMock) UpdateEnvironmentMembershipCalls() []struct {
+	Ctx            context.Context
+	EnvironmentId  string
+	NewEnvironment apiclient.EnvironmentMembershipRequest
+} {
+	var calls []struct {
+		Ctx            context.Context
+		EnvironmentId  string
+		NewEnvironment apiclient.EnvironmentMembershipRequest
+	}
+	mock.lockUpdateEnvironmentMembership.RLock()
+	calls = mock.calls.UpdateEnvironmentMembership
+	mock.lockUpdateEnvironmentMembership.RUnlock()
+	return calls
+}
+
+// UpdateFirewallRule calls UpdateFirewallRuleFunc.
+func (mock *APIMock) UpdateFirewallRule(ctx context.Context, environmentName string, firewallRuleId string, newFirewallRule apiclient.FirewallRule, organizationId string) (*apiclient.TaskStatusResponse, error) {
+	if mock.UpdateFirewallRuleFunc == nil {
+		panic("APIMock.UpdateFirewallRuleFunc: method is nil but API.UpdateFirewallRule was just called")
+	}
+	callInfo := struct {
+		Ctx             context.Context
+		EnvironmentName string
+		FirewallRuleId  string
+		NewFirewallRule apiclient.FirewallRule
+		OrganizationId  string
+	}{
+		Ctx:             ctx,
+		EnvironmentName: environmentName,
+		FirewallRuleId:  firewallRuleId,
+		NewFirewallRule: newFirewallRule,
+		OrganizationId:  organizationId,
+	}
+	mock.lockUpdateFirewallRule.Lock()
+	mock.calls.UpdateFirewallRule = append(mock.calls.UpdateFirewallRule, callInfo)
+	mock.lockUpdateFirewallRule.Unlock()
+	return mock.UpdateFirewallRuleFunc(ctx, environmentName, firewallRuleId, newFirewallRule, organizationId)
+}
+
+// UpdateFirewallRuleCalls gets all the calls that were made to UpdateFirewallRule.
+// Check the length with:
+//
+//	len(mockedAPI.UpdateFirewallRuleCalls())
+func (mock *APIMock) UpdateFirewallRuleCalls() []struct {
+	Ctx             context.Context
+	EnvironmentName string
+	FirewallRuleId  string
+	NewFirewallRule apiclient.FirewallRule
+	OrganizationId  string
+} {
+	var calls []struct {
+		Ctx             context.Context
+		EnvironmentName string
+		FirewallRuleId  string
+		NewFirewallRule apiclient.FirewallRule
+		OrganizationId  string
+	}
+	mock.lockUpdateFirewallRule.RLock()
+	calls = mock.calls.UpdateFirewallRule
+	mock.lockUpdateFirewallRule.RUnlock()
+	return calls
+}
+
+// UpdateNetworkPolicyRule calls UpdateNetworkPolicyRuleFunc.
+func (mock *APIMock) UpdateNetworkPolicyRule(ctx context.Context, networkPolicyRuleId string, newNetworkPolicyRule apiclient.NetworkPolicyRuleCreateRequest, organizationId string) ([]apiclient.NetworkPolicyRule, error) {
+	if mock.UpdateNetworkPolicyRuleFunc == nil {
+		panic("APIMock.UpdateNetworkPolicyRuleFunc: method is nil but API.UpdateNetworkPolicyRule was just called")
+	}
+	callInfo := struct {
+		Ctx                  context.Context
+		NetworkPolicyRuleId  string
+		NewNetworkPolicyRule apiclient.NetworkPolicyRuleCreateRequest
+		OrganizationId       string
+	}{
+		Ctx:                  ctx,
+		NetworkPolicyRuleId:  networkPolicyRuleId,
+		NewNetworkPolicyRule: newNetworkPolicyRule,
+		OrganizationId:       organizationId,
+	}
+	mock.lockUpdateNetworkPolicyRule.Lock()
+	mock.calls.UpdateNetworkPolicyRule = append(mock.calls.UpdateNetworkPolicyRule, callInfo)
+	mock.lockUpdateNetworkPolicyRule.Unlock()
+	return mock.UpdateNetworkPolicyRuleFunc(ctx, networkPolicyRuleId, newNetworkPolicyRule, organizationId)
+}
+
+// UpdateNetworkPolicyRuleCalls gets all the calls that were made to UpdateNetworkPolicyRule.
+// Check the length with:
+//
+//	len(mockedAPI.UpdateNetworkPolicyRuleCalls())
+func (mock *APIMock) UpdateNetworkPolicyRuleCalls() []struct {
+	Ctx                  context.Context
+	NetworkPolicyRuleId  string
+	NewNetworkPolicyRule apiclient.NetworkPolicyRuleCreateRequest
+	OrganizationId       string
+} {
+	var calls []struct {
+		Ctx                  context.Context
+		NetworkPolicyRuleId  string
+		NewNetworkPolicyRule apiclient.NetworkPolicyRuleCreateRequest
+		OrganizationId       string
+	}
+	mock.lockUpdateNetworkPolicyRule.RLock()
+	calls = mock.calls.UpdateNetworkPolicyRule
+	mock.lockUpdateNetworkPolicyRule.RUnlock()
+	return calls
+}
+
+// UpdateOriginSettings calls UpdateOriginSettingsFunc.
+func (mock *APIMock) UpdateOriginSettings(ctx context.Context, originSettingsId string, newOriginSettings apiclient.OriginSettings, organizationId string) (*apiclient.OriginSettings, error) {
+	if mock.UpdateOriginSettingsFunc == nil {
+		panic("APIMock.UpdateOriginSettingsFunc: method is nil but API.UpdateOriginSettings was just called")
+	}
+	callInfo := struct {
+		Ctx               context.Context
+		OriginSettingsId  string
+		NewOriginSettings apiclient.OriginSettings
+		OrganizationId    string
+	}{
+		Ctx:               ctx,
+		OriginSettingsId:  originSettingsId,
+		NewOriginSettings: newOriginSettings,
+		OrganizationId:    organizationId,
+	}
+	mock.lockUpdateOriginSettings.Lock()
+	mock.calls.UpdateOriginSettings = append(mock.calls.UpdateOriginSettings, callInfo)
+	mock.lockUpdateOriginSettings.Unlock()
+	return mock.UpdateOriginSettingsFunc(ctx, originSettingsId, newOriginSettings, organizationId)
+}
+
+// UpdateOriginSettingsCalls gets all the calls that were made to UpdateOriginSettings.
+// Check the length with:
+//
+//	len(mockedAPI.UpdateOriginSettingsCalls())
+func (mock *APIMock) UpdateOriginSettingsCalls() []struct {
+	Ctx               context.Context
+	OriginSettingsId  string
+	NewOriginSettings apiclient.OriginSettings
+	OrganizationId    string
+} {
+	var calls []struct {
+		Ctx               context.Context
+		OriginSettingsId  string
+		NewOriginSettings apiclient.OriginSettings
+		OrganizationId    string
+	}
+	mock.lockUpdateOriginSettings.RLock()
+	calls = mock.calls.UpdateOriginSettings
+	mock.lockUpdateOriginSettings.RUnlock()
+	return calls
+}
+
+// UpdateRole calls UpdateRoleFunc.
+func (mock *APIMock) UpdateRole(ctx context.Context, roleId string, newRole apiclient.RoleCreateRequest) (*apiclient.Roles, error) {
+	if mock.UpdateRoleFunc == nil {
+		panic("APIMock.UpdateRoleFunc: method is nil but API.UpdateRole was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		RoleId  string
+		NewRole apiclient.RoleCreateRequest
+	}{
+		Ctx:     ctx,
+		RoleId:  roleId,
+		NewRole: newRole,
+	}
+	mock.lockUpdateRole.Lock()
+	mock.calls.UpdateRole = append(mock.calls.UpdateRole, callInfo)
+	mock.lockUpdateRole.Unlock()
+	return mock.UpdateRoleFunc(ctx, roleId, newRole)
+}
+
+// UpdateRoleCalls gets all the calls that were made to UpdateRole.
+// Check the length with:
+//
+//	len(mockedAPI.UpdateRoleCalls())
+func (mock *APIMock) UpdateRoleCalls() []struct {
+	Ctx     context.Context
+	RoleId  string
+	NewRole apiclient.RoleCreateRequest
+} {
+	var calls []struct {
+		Ctx     context.Context
+		RoleId  string
+		NewRole apiclient.RoleCreateRequest
+	}
+	mock.lockUpdateRole.RLock()
+	calls = mock.calls.UpdateRole
+	mock.lockUpdateRole.RUnlock()
+	return calls
+}
+
+// UpdateScript calls UpdateScriptFunc.
+func (mock *APIMock) UpdateScript(ctx context.Context, id string, siteId string, environmentName string, newScript apiclient.ScriptCreateRequest, organizationId string) (*apiclient.TaskStatusResponse, error) {
+	if mock.UpdateScriptFunc == nil {
+		panic("APIMock.UpdateScriptFunc: method is nil but API.UpdateScript was just called")
+	}
+	callInfo := struct {
+		Ctx             context.Context
+		ID              string
+		SiteId          string
+		EnvironmentName string
+		NewScript       apiclient.ScriptCreateRequest
+		OrganizationId  string
+	}{
+		Ctx:             ctx,
+		ID:              id,
+		SiteId:          siteId,
+		EnvironmentName: environmentName,
+		NewScript:       newScript,
+		OrganizationId:  organizationId,
+	}
+	mock.lockUpdateScript.Lock()
+	mock.calls.UpdateScript = append(mock.calls.UpdateScript, callInfo)
+	mock.lockUpdateScript.Unlock()
+	return mock.UpdateScriptFunc(ctx, id, siteId, environmentName, newScript, organizationId)
+}
+
+// UpdateScriptCalls gets all the calls that were made to UpdateScript.
+// Check the length with:
+//
+//	len(mockedAPI.UpdateScriptCalls())
+func (mock *APIMock) UpdateScriptCalls() []struct {
+	Ctx             context.Context
+	ID              string
+	SiteId          string
+	EnvironmentName string
+	NewScript       apiclient.ScriptCreateRequest
+	OrganizationId  string
+} {
+	var calls []struct {
+		Ctx             context.Context
+		ID              string
+		SiteId          string
+		EnvironmentName string
+		NewScript       apiclient.ScriptCreateRequest
+		OrganizationId  string
+	}
+	mock.lockUpdateScript.RLock()
+	calls = mock.calls.UpdateScript
+	mock.lockUpdateScript.RUnlock()
+	return calls
+}
+
+// UpdateSite calls UpdateSiteFunc.
+func (mock *APIMock) UpdateSite(ctx context.Context, siteId string, environmentName string, operationValue string, organizationId string) (*apiclient.TaskStatusResponse, error) {
+	if mock.UpdateSiteFunc == nil {
+		panic("APIMock.UpdateSiteFunc: method is nil but API.UpdateSite was just called")
+	}
+	callInfo := struct {
+		Ctx             context.Context
+		SiteId          string
+		EnvironmentName string
+		OperationValue  string
+		OrganizationId  string
+	}{
+		Ctx:             ctx,
+		SiteId:          siteId,
+		EnvironmentName: environmentName,
+		OperationValue:  operationValue,
+		OrganizationId:  organizationId,
+	}
+	mock.lockUpdateSite.Lock()
+	mock.calls.UpdateSite = append(mock.calls.UpdateSite, callInfo)
+	mock.lockUpdateSite.Unlock()
+	return mock.UpdateSiteFunc(ctx, siteId, environmentName, operationValue, organizationId)
+}
+
+// UpdateSiteCalls gets all the calls that were made to UpdateSite.
+// Check the length with:
+//
+//	len(mockedAPI.UpdateSiteCalls())
+func (mock *APIMock) UpdateSiteCalls() []struct {
+	Ctx             context.Context
+	SiteId          string
+	EnvironmentName string
+	OperationValue  string
+	OrganizationId  string
+} {
+	var calls []struct {
+		Ctx             context.Context
+		SiteId          string
+		EnvironmentName string
+		OperationValue  string
+		OrganizationId  string
+	}
+	mock.lockUpdateSite.RLock()
+	calls = mock.calls.UpdateSite
+	mock.lockUpdateSite.RUnlock()
+	return calls
+}
+
+// UpdateUser calls UpdateUserFunc.
+func (mock *APIMock) UpdateUser(ctx context.Context, userId string, newUser apiclient.UserCreateRequest) (*apiclient.User, error) {
+	if mock.UpdateUserFunc == nil {
+		panic("APIMock.UpdateUserFunc: method is nil but API.UpdateUser was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		UserId  string
+		NewUser apiclient.UserCreateRequest
+	}{
+		Ctx:     ctx,
+		UserId:  userId,
+		NewUser: newUser,
+	}
+	mock.lockUpdateUser.Lock()
+	mock.calls.UpdateUser = append(mock.calls.UpdateUser, callInfo)
+	mock.lockUpdateUser.Unlock()
+	return mock.UpdateUserFunc(ctx, userId, newUser)
+}
+
+// UpdateUserCalls gets all the calls that were made to UpdateUser.
+// Check the length with:
+//
+//	len(mockedAPI.UpdateUserCalls())
+func (mock *APIMock) UpdateUserCalls() []struct {
+	Ctx     context.Context
+	UserId  string
+	NewUser apiclient.UserCreateRequest
+} {
+	var calls []struct {
+		Ctx     context.Context
+		UserId  string
+		NewUser apiclient.UserCreateRequest
+	}
+	mock.lockUpdateUser.RLock()
+	calls = mock.calls.UpdateUser
+	mock.lockUpdateUser.RUnlock()
+	return calls
+}
+
+// UpdateWAFSettings calls UpdateWAFSettingsFunc.
+func (mock *APIMock) UpdateWAFSettings(ctx context.Context, wafSettingsId string, newWAFSettings apiclient.WAFSettings, organizationId string) (*apiclient.TaskStatusResponse, error) {
+	if mock.UpdateWAFSettingsFunc == nil {
+		panic("APIMock.UpdateWAFSettingsFunc: method is nil but API.UpdateWAFSettings was just called")
+	}
+	callInfo := struct {
+		Ctx            context.Context
+		WafSettingsId  string
+		NewWAFSettings apiclient.WAFSettings
+		OrganizationId string
+	}{
+		Ctx:            ctx,
+		WafSettingsId:  wafSettingsId,
+		NewWAFSettings: newWAFSettings,
+		OrganizationId: organizationId,
+	}
+	mock.lockUpdateWAFSettings.Lock()
+	mock.calls.UpdateWAFSettings = append(mock.calls.UpdateWAFSettings, callInfo)
+	mock.lockUpdateWAFSettings.Unlock()
+	return mock.UpdateWAFSettingsFunc(ctx, wafSettingsId, newWAFSettings, organizationId)
+}
+
+// UpdateWAFSettingsCalls gets all the calls that were made to UpdateWAFSettings.
+// Check the length with:
+//
+//	len(mockedAPI.UpdateWAFSettingsCalls())
+func (mock *APIMock) UpdateWAFSettingsCalls() []struct {
+	Ctx            context.Context
+	WafSettingsId  string
+	NewWAFSettings apiclient.WAFSettings
+	OrganizationId string
+} {
+	var calls []struct {
+		Ctx            context.Context
+		WafSettingsId  string
+		NewWAFSettings apiclient.WAFSettings
+		OrganizationId string
+	}
+	mock.lockUpdateWAFSettings.RLock()
+	calls = mock.calls.UpdateWAFSettings
+	mock.lockUpdateWAFSettings.RUnlock()
+	return calls
+}
+
+// UpdateWorkload calls UpdateWorkloadFunc.
+func (mock *APIMock) UpdateWorkload(ctx context.Context, workloadId string, newWorkload apiclient.WorkloadCreateRequest, organizationId string) (*apiclient.TaskStatusResponse, error) {
+	if mock.UpdateWorkloadFunc == nil {
+		panic("APIMock.UpdateWorkloadFunc: method is nil but API.UpdateWorkload was just called")
+	}
+	callInfo := struct {
+		Ctx            context.Context
+		WorkloadId     string
+		NewWorkload    apiclient.WorkloadCreateRequest
+		OrganizationId string
+	}{
+		Ctx:            ctx,
+		WorkloadId:     workloadId,
+		NewWorkload:    newWorkload,
+		OrganizationId: organizationId,
+	}
+	mock.lockUpdateWorkload.Lock()
+	mock.calls.UpdateWorkload = append(mock.calls.UpdateWorkload, callInfo)
+	mock.lockUpdateWorkload.Unlock()
+	return mock.UpdateWorkloadFunc(ctx, workloadId, newWorkload, organizationId)
+}
+
+// UpdateWorkloadCalls gets all the calls that were made to UpdateWorkload.
+// Check the length with:
+//
+//	len(mockedAPI.UpdateWorkloadCalls())
+func (mock *APIMock) UpdateWorkloadCalls() []struct {
+	Ctx            context.Context
+	WorkloadId     string
+	NewWorkload    apiclient.WorkloadCreateRequest
+	OrganizationId string
+} {
+	var calls []struct {
+		Ctx            context.Context
+		WorkloadId     string
+		NewWorkload    apiclient.WorkloadCreateRequest
+		OrganizationId string
+	}
+	mock.lockUpdateWorkload.RLock()
+	calls = mock.calls.UpdateWorkload
+	mock.lockUpdateWorkload.RUnlock()
+	return calls
+}
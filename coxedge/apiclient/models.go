@@ -22,6 +22,10 @@ type TaskStatus struct {
 			Id   string `json:"id,omitempty"`
 			Name string `json:"name,omitempty"`
 		} `json:"result,omitempty"`
+		FailureReason string           `json:"failureReason,omitempty"`
+		ErrorCode     APIErrorCode     `json:"errorCode,omitempty"`
+		Messages      []string         `json:"messages,omitempty"`
+		Errors        []APIErrorDetail `json:"errors,omitempty"`
 	}
 }
 
@@ -111,10 +115,13 @@ type WrappedOrganizationBillingInfo struct {
 }
 
 type Roles struct {
-	Id           string `json:"id,omitempty"`
-	Name         string `json:"name,omitempty"`
-	IsSystem     bool   `json:"isSystem,omitempty"`
-	DefaultScope string `json:"defaultScope,omitempty"`
+	Id           string             `json:"id,omitempty"`
+	Name         string             `json:"name,omitempty"`
+	Description  string             `json:"description,omitempty"`
+	IsSystem     bool               `json:"isSystem,omitempty"`
+	DefaultScope string             `json:"defaultScope,omitempty"`
+	Permissions  []string           `json:"permissions,omitempty"`
+	Organization ParentOrganization `json:"organization,omitempty"`
 }
 
 type WrappedRolesData struct {
@@ -340,11 +347,12 @@ type WrappedDeliveryDomains struct {
 	Data []DeliveryDomain `json:"data"`
 }
 
-//CDNSettings
+//CDNSettings The site is only sent in the URL. SiteId and Id stay out of the body until the API is confirmed to
+//accept them there, both used to be tagged siteId, which encoding/json drops on a clash.
 type CDNSettings struct {
 	EnvironmentName               string   `json:"-"`
-	SiteId                        string   `json:"siteId,omitempty"`
-	Id                            string   `json:"siteId,omitempty"`
+	SiteId                        string   `json:"-"`
+	Id                            string   `json:"-"`
 	CacheExpirePolicy             string   `json:"cacheExpirePolicy,omitempty"`
 	CacheTtl                      int      `json:"cacheTtl,omitempty"`
 	QueryStringControl            string   `json:"queryStringControl,omitempty"`
@@ -650,13 +658,14 @@ type WrappedScripts struct {
 
 //Edge Logic
 type EdgeLogic struct {
-	AllowEmptyReferrer        bool     `json:"allowEmptyReferrer,omitempty"`
-	ForceWwwEnabled           bool     `json:"forceWwwEnabled,omitempty"`
+	EnvironmentName           string   `json:"-"`
+	AllowEmptyReferrer        *bool    `json:"allowEmptyReferrer,omitempty"`
+	ForceWwwEnabled           *bool    `json:"forceWwwEnabled,omitempty"`
 	Id                        string   `json:"id,omitempty"`
-	PseudoStreamingEnabled    bool     `json:"pseudoStreamingEnabled,omitempty"`
+	PseudoStreamingEnabled    *bool    `json:"pseudoStreamingEnabled,omitempty"`
 	ReferrerList              []string `json:"referrerList,omitempty"`
-	ReferrerProtectionEnabled bool     `json:"referrerProtectionEnabled,omitempty"`
-	RobotTxtEnabled           bool     `json:"robotTxtEnabled,omitempty"`
+	ReferrerProtectionEnabled *bool    `json:"referrerProtectionEnabled,omitempty"`
+	RobotTxtEnabled           *bool    `json:"robotTxtEnabled,omitempty"`
 	RobotTxtFile              string   `json:"robotTxtFile,omitempty"`
 	ScopeId                   string   `json:"scopeId,omitempty"`
 	StackId                   string   `json:"stackId,omitempty"`
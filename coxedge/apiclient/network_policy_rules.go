@@ -6,9 +6,7 @@
 package apiclient
 
 import (
-	"bytes"
-	"encoding/json"
-	"net/http"
+	"context"
 )
 
 type NetworkPolicyRuleCreateRequest struct {
@@ -29,162 +27,58 @@ type NetworkPolicyList struct {
 }
 
 //GetNetworkPolicyRules Get networkPolicyRules in account
-func (c *Client) GetNetworkPolicyRules(environmentName string, organizationId string) ([]NetworkPolicyRule, error) {
-	request, err := http.NewRequest("GET",
-		CoxEdgeAPIBase+"/services/"+CoxEdgeServiceCode+"/"+environmentName+"/networkpolicyrules"+"?org_id="+organizationId,
-		nil)
-	if err != nil {
-		return nil, err
-	}
-
-	respBytes, err := c.doRequest(request)
-	if err != nil {
-		return nil, err
-	}
-
-	var wrappedAPIStruct WrappedNetworkPolicyRules
-	err = json.Unmarshal(respBytes, &wrappedAPIStruct)
-	if err != nil {
-		return nil, err
-	}
-	return wrappedAPIStruct.Data, nil
+func (c *Client) GetNetworkPolicyRules(ctx context.Context, environmentName string, organizationId string) ([]NetworkPolicyRule, error) {
+	return list[NetworkPolicyRule](ctx, c, c.serviceURL(environmentName, "networkpolicyrules").withOrg(organizationId))
 }
 
-func (c *Client) GetNetworkPolicyRuleWorkload(environmentName string, id string, organizationId string) ([]NetworkPolicyRule, error) {
-	//Create the request
-	request, err := http.NewRequest("GET",
-		CoxEdgeAPIBase+"/services/"+CoxEdgeServiceCode+"/"+environmentName+"/networkpolicyrules?workloadId="+id+"&org_id="+organizationId,
-		nil)
-	if err != nil {
-		return nil, err
-	}
-
-	//Execute request
-	respBytes, err := c.doRequest(request)
-	if err != nil {
-		return nil, err
-	}
-
-	var wrappedAPIStruct WrappedNetworkPolicyRules
-	err = json.Unmarshal(respBytes, &wrappedAPIStruct)
-	if err != nil {
-		return nil, err
-	}
-	return wrappedAPIStruct.Data, nil
+func (c *Client) GetNetworkPolicyRuleWorkload(ctx context.Context, environmentName string, id string, organizationId string) ([]NetworkPolicyRule, error) {
+	return list[NetworkPolicyRule](ctx, c, c.serviceURL(environmentName, "networkpolicyrules").withId("workloadId", id).withOrg(organizationId))
 }
 
 //GetNetworkPolicyRule Get networkPolicyRule in account by id
-func (c *Client) GetNetworkPolicyRule(environmentName string, id string, organizationId string) (*NetworkPolicyRule, error) {
-	//Create the request
-	request, err := http.NewRequest("GET",
-		CoxEdgeAPIBase+"/services/"+CoxEdgeServiceCode+"/"+environmentName+"/networkpolicyrules/"+id+"?org_id="+organizationId,
-		nil)
-	if err != nil {
-		return nil, err
-	}
-
-	//Execute request
-	respBytes, err := c.doRequest(request)
-	if err != nil {
-		return nil, err
-	}
-
-	//Unmarshal, unwrap, and return
-	var wrappedAPIStruct WrappedNetworkPolicyRule
-	err = json.Unmarshal(respBytes, &wrappedAPIStruct)
-	if err != nil {
-		return nil, err
-	}
-	return &wrappedAPIStruct.Data, nil
+func (c *Client) GetNetworkPolicyRule(ctx context.Context, environmentName string, id string, organizationId string) (*NetworkPolicyRule, error) {
+	return get[NetworkPolicyRule](ctx, c, c.serviceURL(environmentName, "networkpolicyrules", id).withOrg(organizationId))
 }
 
 //CreateNetworkPolicyRule Create the networkPolicyRule
-func (c *Client) CreateNetworkPolicyRule(newNetworkPolicyRule NetworkPolicyRuleCreateRequest, organizationId string) ([]NetworkPolicyRule, error) {
+func (c *Client) CreateNetworkPolicyRule(ctx context.Context, newNetworkPolicyRule NetworkPolicyRuleCreateRequest, organizationId string) ([]NetworkPolicyRule, error) {
 	var networkResponse []NetworkPolicyRule
-	//Marshal the request
 	for _, entry := range newNetworkPolicyRule.NetworkPolicy {
-		jsonBytes, err := json.Marshal(entry)
-		if err != nil {
-			return nil, err
-		}
-		//Wrap bytes in reader
-		bReader := bytes.NewReader(jsonBytes)
-		//Create the request
-		request, err := http.NewRequest("POST",
-			CoxEdgeAPIBase+"/services/"+CoxEdgeServiceCode+"/"+newNetworkPolicyRule.EnvironmentName+"/networkpolicyrules?org_id="+organizationId,
-			bReader,
+		rule, err := create[NetworkPolicyRule](ctx, c,
+			c.serviceURL(newNetworkPolicyRule.EnvironmentName, "networkpolicyrules").withOrg(organizationId),
+			entry,
 		)
-		request.Header.Set("Content-Type", "application/json")
-		//Execute request
-		respBytes, err := c.doRequest(request)
 		if err != nil {
 			return nil, err
 		}
-		//Return struct
-		var wrappedAPIStruct WrappedNetworkPolicyRule
-		err = json.Unmarshal(respBytes, &wrappedAPIStruct)
-		if err != nil {
-			return nil, err
-		}
-		networkResponse = append(networkResponse, wrappedAPIStruct.Data)
+		networkResponse = append(networkResponse, *rule)
 	}
 	return networkResponse, nil
-
 }
 
 //UpdateNetworkPolicyRule Update a networkPolicyRule
-func (c *Client) UpdateNetworkPolicyRule(networkPolicyRuleId string, newNetworkPolicyRule NetworkPolicyRuleCreateRequest, organizationId string) ([]NetworkPolicyRule, error) {
+func (c *Client) UpdateNetworkPolicyRule(ctx context.Context, networkPolicyRuleId string, newNetworkPolicyRule NetworkPolicyRuleCreateRequest, organizationId string) ([]NetworkPolicyRule, error) {
 	var networkPolicy []NetworkPolicyRule
-
 	for _, entry := range newNetworkPolicyRule.NetworkPolicy {
-		//Marshal the request
-		jsonBytes, err := json.Marshal(entry)
-		if err != nil {
-			return nil, err
-		}
-		//Wrap bytes in reader
-		bReader := bytes.NewReader(jsonBytes)
-		//Create the request
-		request, err := http.NewRequest("PUT",
-			CoxEdgeAPIBase+"/services/"+CoxEdgeServiceCode+"/"+newNetworkPolicyRule.EnvironmentName+"/networkpolicyrules/"+entry.Id+"?org_id="+organizationId,
-			bReader,
+		rule, err := update[NetworkPolicyRule](ctx, c, "PUT",
+			c.serviceURL(newNetworkPolicyRule.EnvironmentName, "networkpolicyrules", entry.Id).withOrg(organizationId),
+			entry,
 		)
-		request.Header.Set("Content-Type", "application/json")
-		//Execute request
-		respBytes, err := c.doRequest(request)
-		if err != nil {
-			return nil, err
-		}
-		//Return struct
-		var wrappedAPIStruct WrappedNetworkPolicyRule
-		err = json.Unmarshal(respBytes, &wrappedAPIStruct)
 		if err != nil {
 			return nil, err
 		}
-		networkPolicy = append(networkPolicy, wrappedAPIStruct.Data)
+		networkPolicy = append(networkPolicy, *rule)
 	}
-
 	return networkPolicy, nil
 }
 
 //DeleteNetworkPolicyRule Delete networkPolicyRule in account by id
-func (c *Client) DeleteNetworkPolicyRule(environmentName string, id string, organizationId string, newNetworkPolicyRule NetworkPolicyRuleCreateRequest) error {
+func (c *Client) DeleteNetworkPolicyRule(ctx context.Context, environmentName string, id string, organizationId string, newNetworkPolicyRule NetworkPolicyRuleCreateRequest) error {
 	for _, entry := range newNetworkPolicyRule.NetworkPolicy {
-		//Create the request
-		request, err := http.NewRequest("DELETE",
-			CoxEdgeAPIBase+"/services/"+CoxEdgeServiceCode+"/"+environmentName+"/networkpolicyrules/"+entry.Id+"?org_id="+organizationId,
-			nil,
-		)
-		if err != nil {
-			return err
-		}
-
-		//Execute request
-		_, err = c.doRequest(request)
+		err := remove(ctx, c, c.serviceURL(environmentName, "networkpolicyrules", entry.Id).withOrg(organizationId))
 		if err != nil {
 			return err
 		}
 	}
-
 	return nil
 }
@@ -5,13 +5,42 @@
  */
 package apiclient
 
-import "testing"
+import (
+	"context"
+	"coxedge/terraform-provider/coxedge/apiclient/fake"
+	"testing"
+)
 
 func TestClient_GetNetworkPolicyRules(t *testing.T) {
-	items, err := apiClient.GetNetworkPolicyRules("base-test-env","")
+	workloadId := createTestWorkload(t, "test-network-policy")
+	newRules := NetworkPolicyRuleCreateRequest{
+		EnvironmentName: fake.EnvironmentName,
+		NetworkPolicy: []NetworkPolicyList{
+			{WorkloadId: workloadId, Description: "http", Protocol: "TCP", Type: "INBOUND", Action: "ALLOW", Source: "0.0.0.0/0", PortRange: "80"},
+		},
+	}
+	created, err := apiClient.CreateNetworkPolicyRule(context.TODO(), newRules, fakeServer.OrganizationId)
 	if err != nil {
-		t.Error(err)
+		t.Fatal(err)
+	}
+	if len(created) != 1 || created[0].Id == "" {
+		t.Fatalf("unexpected rules %+v", created)
 	}
 
+	items, err := apiClient.GetNetworkPolicyRules(context.TODO(), fake.EnvironmentName, fakeServer.OrganizationId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(items) == 0 {
+		t.Error("Got 0 items")
+	}
+
+	items, err = apiClient.GetNetworkPolicyRuleWorkload(context.TODO(), fake.EnvironmentName, workloadId, fakeServer.OrganizationId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(items) != 1 || items[0].PortRange != "80" {
+		t.Errorf("unexpected rules for the workload %+v", items)
+	}
 	t.Logf("Got %d Items\n", len(items))
 }
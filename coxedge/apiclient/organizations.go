@@ -6,70 +6,19 @@
 package apiclient
 
 import (
-	"encoding/json"
-	"fmt"
-	"net/http"
+	"context"
 )
 
 //GetOrganizations Get organizations in account
-func (c *Client) GetOrganizations() ([]Organization, error) {
-	request, err := http.NewRequest("GET", CoxEdgeAPIBase+"/organizations", nil)
-	if err != nil {
-		return nil, err
-	}
-
-	respBytes, err := c.doRequest(request)
-	if err != nil {
-		return nil, err
-	}
-
-	var wrappedAPIStruct WrappedOrganizations
-	err = json.Unmarshal(respBytes, &wrappedAPIStruct)
-	if err != nil {
-		return nil, err
-	}
-	return wrappedAPIStruct.Data, nil
+func (c *Client) GetOrganizations(ctx context.Context) ([]Organization, error) {
+	return list[Organization](ctx, c, c.newURL("organizations"))
 }
 
 //GetOrganization Get organizations in account by id
-func (c *Client) GetOrganization(id string) (*Organization, error) {
-	request, err := http.NewRequest("GET", CoxEdgeAPIBase+"/organizations/"+id, nil)
-	if err != nil {
-		return nil, err
-	}
-
-	respBytes, err := c.doRequest(request)
-
-	fmt.Println(string(respBytes))
-	if err != nil {
-		return nil, err
-	}
-
-	var wrappedAPIStruct WrappedOrganization
-	err = json.Unmarshal(respBytes, &wrappedAPIStruct)
-	if err != nil {
-		return nil, err
-	}
-	return &wrappedAPIStruct.Data, nil
+func (c *Client) GetOrganization(ctx context.Context, id string) (*Organization, error) {
+	return get[Organization](ctx, c, c.newURL("organizations", id))
 }
 
-func (c *Client) GetOrganizationBillingInfo(id string) (*OrganizationBillingInfo, error) {
-	request, err := http.NewRequest("GET", CoxEdgeAPIBase+"/organizations/"+id+"/billing_info", nil)
-	if err != nil {
-		return nil, err
-	}
-
-	respBytes, err := c.doRequest(request)
-
-	fmt.Println(string(respBytes))
-	if err != nil {
-		return nil, err
-	}
-
-	var wrappedAPIStruct WrappedOrganizationBillingInfo
-	err = json.Unmarshal(respBytes, &wrappedAPIStruct)
-	if err != nil {
-		return nil, err
-	}
-	return &wrappedAPIStruct.Data, nil
+func (c *Client) GetOrganizationBillingInfo(ctx context.Context, id string) (*OrganizationBillingInfo, error) {
+	return get[OrganizationBillingInfo](ctx, c, c.newURL("organizations", id, "billing_info"))
 }
@@ -6,45 +6,81 @@
 package apiclient
 
 import (
+	"context"
+	"coxedge/terraform-provider/coxedge/apiclient/fake"
 	"os"
 	"testing"
+	"time"
 )
 
-var apiKey string
+var fakeServer *fake.Server
 var apiClient Client
 
-var firstOrgId string
-var firstOrgRoleId string
-
 func TestMain(m *testing.M) {
-	apiKey = os.Getenv("TEST_API_KEY")
-	apiClient = NewClient(apiKey)
+	fakeServer = fake.NewServer()
+	apiClient = NewClient(fake.APIKey, fakeServer.URL, fake.ServiceCode)
+	//The fake has no rate limits to protect
+	apiClient.SetRateLimit(RateLimit{})
 	code := m.Run()
+	fakeServer.Close()
 	os.Exit(code)
 }
 
+//awaitTask Resolve a task of the fake without waiting for the default poll intervals
+func awaitTask(t *testing.T, taskId string) *TaskStatus {
+	taskResult, err := apiClient.AwaitTaskResolve(context.TODO(), taskId, time.Millisecond, time.Millisecond, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return taskResult
+}
+
 func TestGetOrganizations(t *testing.T) {
-	orgs, err := apiClient.GetOrganizations()
+	orgs, err := apiClient.GetOrganizations(context.TODO())
 	if err != nil {
-		t.Error(err)
+		t.Fatal(err)
 	}
 	if len(orgs) == 0 {
-		t.Error("Got 0 organizations")
+		t.Fatal("Got 0 organizations")
 	}
 
-	firstOrgId = orgs[0].Id
+	var serviceConnectionId string
 	for _, sc := range orgs[0].ServiceConnections {
 		if sc.Name == "Edge Compute" {
 			serviceConnectionId = sc.Id
 		}
 	}
+	if serviceConnectionId == "" {
+		t.Error("Edge Compute service connection missing")
+	}
 	t.Logf("Got %d Organizations\n", len(orgs))
 }
 
 func TestGetOrganization(t *testing.T) {
-	org, err := apiClient.GetOrganization(firstOrgId)
+	org, err := apiClient.GetOrganization(context.TODO(), fakeServer.OrganizationId)
 	if err != nil {
-		t.Error(err)
+		t.Fatal(err)
+	}
+	if org.Id != fakeServer.OrganizationId {
+		t.Errorf("Got organization %s", org.Id)
 	}
 	t.Logf("Got organization with ID: %s\n", org.Id)
 }
+
+func TestGetOrganizationBillingInfo(t *testing.T) {
+	billingInfo, err := apiClient.GetOrganizationBillingInfo(context.TODO(), fakeServer.OrganizationId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if billingInfo.Organization.Id != fakeServer.OrganizationId {
+		t.Errorf("Got billing info for organization %s", billingInfo.Organization.Id)
+	}
+}
+
+func TestInvalidKeyIsRejected(t *testing.T) {
+	client := NewClient("wrong-key", fakeServer.URL, fake.ServiceCode)
+	_, err := client.GetOrganizations(context.TODO())
+	if !hasStatusCode(err, 401) {
+		t.Errorf("expected a 401, got %v", err)
+	}
+}
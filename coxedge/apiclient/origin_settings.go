@@ -6,73 +6,24 @@
 package apiclient
 
 import (
-	"bytes"
-	"encoding/json"
+	"context"
 	"errors"
-	"net/http"
 )
 
 //GetOriginSettings Get originSettings in account by id
-func (c *Client) GetOriginSettings(environmentName string, id string, organizationId string) (*OriginSettings, error) {
-	//Create the request
-	request, err := http.NewRequest("GET",
-		CoxEdgeAPIBase+"/services/"+CoxEdgeServiceCode+"/"+environmentName+"/originsettings/"+id+"?org_id="+organizationId,
-		nil,
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	//Execute request
-	respBytes, err := c.doRequest(request)
-	if err != nil {
-		return nil, err
-	}
-
-	//Unmarshal, unwrap, and return
-	var wrappedAPIStruct WrappedOriginSettings
-	err = json.Unmarshal(respBytes, &wrappedAPIStruct)
-	if err != nil {
-		return nil, err
-	}
-	return &wrappedAPIStruct.Data, nil
+func (c *Client) GetOriginSettings(ctx context.Context, environmentName string, id string, organizationId string) (*OriginSettings, error) {
+	return get[OriginSettings](ctx, c, c.serviceURL(environmentName, "originsettings", id).withOrg(organizationId))
 }
 
 //CreateOriginSettings Create the originSettings
-func (c *Client) CreateOriginSettings(newOriginSettings OriginSettings) (*OriginSettings, error) {
+func (c *Client) CreateOriginSettings(ctx context.Context, newOriginSettings OriginSettings) (*OriginSettings, error) {
 	return nil, errors.New("cannot create OriginSettings")
 }
 
 //UpdateOriginSettings Update a originSettings
-func (c *Client) UpdateOriginSettings(originSettingsId string, newOriginSettings OriginSettings, organizationId string) (*OriginSettings, error) {
-	//Marshal the request
-	jsonBytes, err := json.Marshal(newOriginSettings)
-	if err != nil {
-		return nil, err
-	}
-	//Wrap bytes in reader
-	bReader := bytes.NewReader(jsonBytes)
-	//Create the request
-	request, err := http.NewRequest("PATCH",
-		CoxEdgeAPIBase+"/services/"+CoxEdgeServiceCode+"/"+newOriginSettings.EnvironmentName+"/originsettings/"+originSettingsId+"?org_id="+organizationId,
-		bReader,
+func (c *Client) UpdateOriginSettings(ctx context.Context, originSettingsId string, newOriginSettings OriginSettings, organizationId string) (*OriginSettings, error) {
+	return update[OriginSettings](ctx, c, "PATCH",
+		c.serviceURL(newOriginSettings.EnvironmentName, "originsettings", originSettingsId).withOrg(organizationId),
+		newOriginSettings,
 	)
-	request.Header.Set("Content-Type", "application/json")
-	//Execute request
-	respBytes, err := c.doRequest(request)
-	if err != nil {
-		return nil, err
-	}
-	//Return struct
-	var wrappedAPIStruct WrappedOriginSettings
-	err = json.Unmarshal(respBytes, &wrappedAPIStruct)
-	if err != nil {
-		return nil, err
-	}
-	return &wrappedAPIStruct.Data, nil
-}
-
-//DeleteOriginSettings Delete originSettings in account by id
-func (c *Client) DeleteOriginSettings(environmentName string, id string) error {
-	return errors.New("cannot delete OriginSettings")
 }
@@ -1,27 +1,48 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
 package apiclient
 
-import "testing"
+import (
+	"context"
+	"coxedge/terraform-provider/coxedge/apiclient/fake"
+	"testing"
+)
 
 func TestGetOriginSettings(t *testing.T) {
-	res, err := apiClient.GetOriginSettings("test-codecraft", "352cdc1e-c071-49ad-bddd-371094880507")
+	siteId := createTestSite(t, "origin-get.cc.com")
+	res, err := apiClient.GetOriginSettings(context.TODO(), fake.EnvironmentName, siteId, fakeServer.OrganizationId)
 	if err != nil {
-		t.Error(err)
+		t.Fatal(err)
+	}
+	if res.Id != siteId {
+		t.Errorf("Got %v origin", res.Id)
 	}
-	t.Logf("Got %v origin", res.Id)
 }
 
 func TestUpdateOriginSettings(t *testing.T) {
-
+	siteId := createTestSite(t, "origin-update.cc.com")
 	org := OriginSettingsOrigin{
 		Address: "cc.coxedge.com",
 	}
 	orga := OriginSettings{
-		EnvironmentName: "test-codecraft",
+		EnvironmentName: fake.EnvironmentName,
 		Origin:          org,
 	}
-	res, err := apiClient.UpdateOriginSettings("352cdc1e-c071-49ad-bddd-371094880507", orga)
+	res, err := apiClient.UpdateOriginSettings(context.TODO(), siteId, orga, fakeServer.OrganizationId)
 	if err != nil {
-		t.Error(err)
+		t.Fatal(err)
+	}
+	if res.Origin.Address != "cc.coxedge.com" {
+		t.Errorf("Got %v origin", res.Origin)
+	}
+}
+
+func TestGetOriginSettingsOfMissingSite(t *testing.T) {
+	_, err := apiClient.GetOriginSettings(context.TODO(), fake.EnvironmentName, "missing-site", fakeServer.OrganizationId)
+	if !IsNotFound(err) {
+		t.Errorf("expected a 404, got %v", err)
 	}
-	t.Logf("Got %v origin", res.Id)
 }
@@ -0,0 +1,176 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+package apiclient
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"strconv"
+)
+
+//DefaultPageSize Number of objects asked for per page of a list endpoint, used by NewClient
+const DefaultPageSize = 100
+
+//pageMetadata Paging details the portal returns with each page of a list
+type pageMetadata struct {
+	RecordCount int `json:"recordCount"`
+}
+
+//wrappedPage A page of a list endpoint. ids holds the IDs of the objects in Data.
+type wrappedPage[T any] struct {
+	Data     []T           `json:"data"`
+	Metadata *pageMetadata `json:"metadata"`
+	ids      []string
+}
+
+//UnmarshalJSON Decode the page and the IDs of its objects, which tell a repeated page apart from the next one
+func (p *wrappedPage[T]) UnmarshalJSON(b []byte) error {
+	var page struct {
+		Data     []T           `json:"data"`
+		Metadata *pageMetadata `json:"metadata"`
+	}
+	if err := json.Unmarshal(b, &page); err != nil {
+		return err
+	}
+	var ids struct {
+		Data []struct {
+			Id string `json:"id"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(b, &ids); err != nil {
+		return err
+	}
+
+	p.Data = page.Data
+	p.Metadata = page.Metadata
+	p.ids = make([]string, len(ids.Data))
+	for i, object := range ids.Data {
+		p.ids[i] = object.Id
+	}
+	return nil
+}
+
+//repeats Whether the page holds the same objects as the page with previousIds. Pages of objects without IDs never
+//repeat.
+func (p *wrappedPage[T]) repeats(previousIds []string) bool {
+	if len(p.ids) == 0 || len(p.ids) != len(previousIds) {
+		return false
+	}
+	for i, id := range p.ids {
+		if id == "" || id != previousIds[i] {
+			return false
+		}
+	}
+	return true
+}
+
+//PageIterator Walk a list endpoint one page at a time, so very large collections don't have to be held in memory.
+//
+//	pages := client.SitesPages(ctx, environmentName, organizationId)
+//	for pages.Next() {
+//		for _, site := range pages.Page() {
+//			...
+//		}
+//	}
+//	if err := pages.Err(); err != nil {
+//		return err
+//	}
+type PageIterator[T any] struct {
+	client   *Client
+	ctx      context.Context
+	url      *requestURL
+	pageSize int
+	page     int
+	seen     int
+	items    []T
+	ids      []string
+	done     bool
+	err      error
+}
+
+//newPageIterator Iterate the list endpoint at u
+func newPageIterator[T any](ctx context.Context, c *Client, u *requestURL) *PageIterator[T] {
+	pageSize := c.PageSize
+	if pageSize <= 0 {
+		pageSize = DefaultPageSize
+	}
+	return &PageIterator[T]{
+		client:   c,
+		ctx:      ctx,
+		url:      u,
+		pageSize: pageSize,
+	}
+}
+
+//Next Fetch the next page. It returns false once the collection is exhausted or a request failed, see Err.
+//
+//An endpoint that ignores the paging parameters would be read forever, so a page larger than the page size is taken
+//as the whole collection and a page repeating the previous one ends the iteration.
+func (it *PageIterator[T]) Next() bool {
+	if it.done || it.err != nil {
+		return false
+	}
+	it.page++
+
+	it.url.with("page", strconv.Itoa(it.page))
+	it.url.with("size", strconv.Itoa(it.pageSize))
+	page, err := send[wrappedPage[T]](it.ctx, it.client, "GET", it.url, nil)
+	if err != nil {
+		it.err = err
+		return false
+	}
+
+	if it.page > 1 && page.repeats(it.ids) {
+		tflog.Warn(it.ctx, "List endpoint returned the previous page again, stopping", "url", it.url.String(), "page", it.page)
+		it.done = true
+		return false
+	}
+	if len(page.Data) > it.pageSize {
+		tflog.Warn(it.ctx, "List endpoint returned more objects than the page size, taking them as the whole list", "url", it.url.String(), "page_size", it.pageSize, "objects", len(page.Data))
+		it.items = page.Data
+		it.ids = page.ids
+		it.done = true
+		return true
+	}
+
+	it.items = page.Data
+	it.ids = page.ids
+	it.seen += len(page.Data)
+	//Trust the record count when the portal sends one, else stop at the first short page
+	if page.Metadata != nil {
+		it.done = it.seen >= page.Metadata.RecordCount
+	} else {
+		it.done = len(page.Data) < it.pageSize
+	}
+	if len(page.Data) == 0 {
+		it.done = true
+		return false
+	}
+	return true
+}
+
+//Page The objects of the current page
+func (it *PageIterator[T]) Page() []T {
+	return it.items
+}
+
+//Err The error that stopped the iteration, nil when the collection was read to the end
+func (it *PageIterator[T]) Err() error {
+	return it.err
+}
+
+//all Read every page
+func (it *PageIterator[T]) all() ([]T, error) {
+	items := []T{}
+	for it.Next() {
+		items = append(items, it.items...)
+	}
+	if it.err != nil {
+		return nil, it.err
+	}
+	return items, nil
+}
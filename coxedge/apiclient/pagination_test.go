@@ -0,0 +1,169 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+package apiclient
+
+import (
+	"context"
+	"coxedge/terraform-provider/coxedge/apiclient/fake"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetSitesReadsEveryPage(t *testing.T) {
+	for i := 0; i < 5; i++ {
+		createTestSite(t, fmt.Sprintf("page%d.cc.com", i))
+	}
+	allSites, err := apiClient.GetSites(context.TODO(), fake.EnvironmentName, fakeServer.OrganizationId)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client := apiClient
+	client.PageSize = 2
+	sites, err := client.GetSites(context.TODO(), fake.EnvironmentName, fakeServer.OrganizationId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sites) != len(allSites) {
+		t.Fatalf("expected %d sites over pages of 2, got %d", len(allSites), len(sites))
+	}
+	seen := map[string]bool{}
+	for _, site := range sites {
+		if seen[site.Id] {
+			t.Errorf("site %s returned twice", site.Id)
+		}
+		seen[site.Id] = true
+	}
+}
+
+func TestPageIterator(t *testing.T) {
+	for i := 0; i < 3; i++ {
+		createTestSite(t, fmt.Sprintf("iterate%d.cc.com", i))
+	}
+
+	client := apiClient
+	client.PageSize = 2
+	pages := client.SitesPages(context.TODO(), fake.EnvironmentName, fakeServer.OrganizationId)
+	pageCount, siteCount := 0, 0
+	for pages.Next() {
+		sites := pages.Page()
+		if len(sites) == 0 || len(sites) > 2 {
+			t.Errorf("expected pages of 1 or 2 sites, got %d", len(sites))
+		}
+		pageCount++
+		siteCount += len(sites)
+	}
+	if err := pages.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if pageCount != (siteCount+1)/2 {
+		t.Errorf("expected %d pages for %d sites, got %d", (siteCount+1)/2, siteCount, pageCount)
+	}
+}
+
+func TestPageIteratorWithoutMetadata(t *testing.T) {
+	var requested []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = append(requested, r.URL.RawQuery)
+		switch r.URL.Query().Get("page") {
+		case "1":
+			w.Write([]byte(`{"data":[{"id":"a"},{"id":"b"}]}`))
+		default:
+			w.Write([]byte(`{"data":[{"id":"c"}]}`))
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("test-key", server.URL, "")
+	client.PageSize = 2
+	users, err := client.GetUsers(context.TODO())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(users) != 3 {
+		t.Errorf("expected the short page to end the list after 3 users, got %d", len(users))
+	}
+	if len(requested) != 2 || requested[0] != "page=1&size=2" || requested[1] != "page=2&size=2" {
+		t.Errorf("unexpected page requests %v", requested)
+	}
+}
+
+func TestPageIteratorError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page") == "1" {
+			w.Write([]byte(`{"data":[{"id":"a"}],"metadata":{"recordCount":2}}`))
+			return
+		}
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"errors":[{"code":400,"message":"bad page"}]}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-key", server.URL, "")
+	if _, err := client.GetUsers(context.TODO()); err == nil {
+		t.Error("expected a failed page to fail the whole list")
+	}
+}
+
+func TestPageIteratorIgnoredPaging(t *testing.T) {
+	testCases := []struct {
+		name           string
+		body           string
+		expectUsers    int
+		expectRequests int
+	}{
+		{
+			name:           "full page repeated",
+			body:           `{"data":[{"id":"a"},{"id":"b"}]}`,
+			expectUsers:    2,
+			expectRequests: 2,
+		},
+		{
+			name:           "page repeated short of the record count",
+			body:           `{"data":[{"id":"a"},{"id":"b"}],"metadata":{"recordCount":5}}`,
+			expectUsers:    2,
+			expectRequests: 2,
+		},
+		{
+			name:           "whole list on every page",
+			body:           `{"data":[{"id":"a"},{"id":"b"},{"id":"c"}]}`,
+			expectUsers:    3,
+			expectRequests: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			requests := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				if requests > 10 {
+					//Fail the test instead of hanging it
+					w.WriteHeader(http.StatusBadRequest)
+					w.Write([]byte(`{"errors":[{"code":400,"message":"paged too often"}]}`))
+					return
+				}
+				w.Write([]byte(tc.body))
+			}))
+			defer server.Close()
+
+			client := NewClient("test-key", server.URL, "")
+			client.PageSize = 2
+			users, err := client.GetUsers(context.TODO())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(users) != tc.expectUsers {
+				t.Errorf("expected %d users, got %d", tc.expectUsers, len(users))
+			}
+			if requests != tc.expectRequests {
+				t.Errorf("expected %d requests, got %d", tc.expectRequests, requests)
+			}
+		})
+	}
+}
@@ -0,0 +1,126 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+package apiclient
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+const DefaultRateLimitRequestsPerSecond = 10
+const DefaultRateLimitBurst = 10
+
+//DefaultMaxInFlightRequests No cap by default, Terraform's -parallelism already bounds the concurrent requests
+const DefaultMaxInFlightRequests = 0
+
+//RateLimit Controls how fast requests are sent. The limits are shared by every copy of the client, so by every
+//resource of a run.
+type RateLimit struct {
+	//RequestsPerSecond Sustained rate of requests, refilling a token bucket. Values below or equal to 0 disable it.
+	RequestsPerSecond float64
+	//Burst Size of the token bucket, the number of requests that may be sent at once after a quiet period
+	Burst int
+	//MaxInFlight Number of requests awaiting a response at any time. Values below 1 remove the cap.
+	MaxInFlight int
+}
+
+//DefaultRateLimit The limits used by NewClient
+func DefaultRateLimit() RateLimit {
+	return RateLimit{
+		RequestsPerSecond: DefaultRateLimitRequestsPerSecond,
+		Burst:             DefaultRateLimitBurst,
+		MaxInFlight:       DefaultMaxInFlightRequests,
+	}
+}
+
+//limiter A token bucket and a semaphore of in-flight requests
+type limiter struct {
+	mutex    sync.Mutex
+	rate     float64
+	burst    float64
+	tokens   float64
+	last     time.Time
+	inFlight chan struct{}
+}
+
+func newLimiter(rateLimit RateLimit) *limiter {
+	l := &limiter{
+		rate:  rateLimit.RequestsPerSecond,
+		burst: float64(rateLimit.Burst),
+		last:  time.Now(),
+	}
+	if l.burst < 1 {
+		l.burst = 1
+	}
+	l.tokens = l.burst
+	if rateLimit.MaxInFlight > 0 {
+		l.inFlight = make(chan struct{}, rateLimit.MaxInFlight)
+	}
+	return l
+}
+
+//acquire Wait for a token and a free in-flight slot, returning how long that took. Every successful acquire must
+//be followed by a release.
+func (l *limiter) acquire(ctx context.Context) (time.Duration, error) {
+	start := time.Now()
+
+	if wait := l.reserve(); wait > 0 {
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			l.cancelReservation()
+			return time.Since(start), ctx.Err()
+		case <-timer.C:
+		}
+	}
+
+	if l.inFlight != nil {
+		select {
+		case <-ctx.Done():
+			return time.Since(start), ctx.Err()
+		case l.inFlight <- struct{}{}:
+		}
+	}
+	return time.Since(start), nil
+}
+
+//release Free the in-flight slot taken by acquire
+func (l *limiter) release() {
+	if l.inFlight != nil {
+		<-l.inFlight
+	}
+}
+
+//reserve Take a token, possibly ahead of time, and return how long to wait until it is due
+func (l *limiter) reserve() time.Duration {
+	if l.rate <= 0 {
+		return 0
+	}
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	now := time.Now()
+	l.tokens += now.Sub(l.last).Seconds() * l.rate
+	if l.tokens > l.burst {
+		l.tokens = l.burst
+	}
+	l.last = now
+
+	l.tokens--
+	if l.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-l.tokens / l.rate * float64(time.Second))
+}
+
+//cancelReservation Give back the token of a request that gave up waiting
+func (l *limiter) cancelReservation() {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	l.tokens++
+}
@@ -0,0 +1,109 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+package apiclient
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestLimiterAllowsBurstThenWaits(t *testing.T) {
+	l := newLimiter(RateLimit{RequestsPerSecond: 20, Burst: 3})
+
+	for i := 0; i < 3; i++ {
+		if wait := l.reserve(); wait != 0 {
+			t.Fatalf("request %d of the burst waited %s", i+1, wait)
+		}
+	}
+	wait := l.reserve()
+	if wait < 40*time.Millisecond || wait > 50*time.Millisecond {
+		t.Errorf("expected the request after the burst to wait about 50ms, got %s", wait)
+	}
+}
+
+func TestLimiterGivesBackTokenOnCancel(t *testing.T) {
+	l := newLimiter(RateLimit{RequestsPerSecond: 1, Burst: 1})
+	l.reserve()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := l.acquire(ctx); err != context.Canceled {
+		t.Fatalf("expected the canceled context error, got %v", err)
+	}
+	//Only the first reservation is still owed
+	if wait := l.reserve(); wait > time.Second {
+		t.Errorf("expected the canceled request to give its token back, got a wait of %s", wait)
+	}
+}
+
+func TestClientCapsInFlightRequests(t *testing.T) {
+	var inFlight, maxInFlight int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := atomic.AddInt32(&inFlight, 1)
+		for {
+			seen := atomic.LoadInt32(&maxInFlight)
+			if current <= seen || atomic.CompareAndSwapInt32(&maxInFlight, seen, current) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		atomic.AddInt32(&inFlight, -1)
+		w.Write([]byte(`{"data":[]}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-key", server.URL, "")
+	client.SetRateLimit(RateLimit{MaxInFlight: 2})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := client.GetOrganizations(context.Background()); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if maxInFlight != 2 {
+		t.Errorf("expected at most 2 requests in flight, saw %d", maxInFlight)
+	}
+}
+
+func TestNewClientDoesNotCapInFlightRequests(t *testing.T) {
+	client := NewClient("test-key", "http://api.coxedge.invalid", "")
+	if client.limiter.inFlight != nil {
+		t.Errorf("expected no in-flight cap by default, got %d", cap(client.limiter.inFlight))
+	}
+}
+
+func TestClientRateLimitIsSharedByCopies(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"data":[]}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-key", server.URL, "")
+	client.SetRateLimit(RateLimit{RequestsPerSecond: 20, Burst: 1})
+	clientCopy := client
+
+	start := time.Now()
+	for _, c := range []*Client{&client, &clientCopy, &client} {
+		if _, err := c.GetOrganizations(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 90*time.Millisecond {
+		t.Errorf("expected 3 requests at 20 per second to take about 100ms, took %s", elapsed)
+	}
+}
@@ -0,0 +1,181 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+package apiclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+//requestURL Builder of API URLs that escapes path segments and query values. The first invalid argument is kept in
+//err and fails the request before it is sent.
+type requestURL struct {
+	base     string
+	segments []string
+	query    url.Values
+	err      error
+}
+
+//newURL URL of the path segments below the API base, each of them an ID or a constant
+func (c *Client) newURL(segments ...string) *requestURL {
+	u := &requestURL{
+		base:     c.apiBase,
+		segments: segments,
+		query:    url.Values{},
+	}
+	for _, segment := range segments {
+		u.check(ValidateId("ID", segment))
+	}
+	return u
+}
+
+//serviceURL URL of the path segments below the service of an environment
+func (c *Client) serviceURL(environmentName string, segments ...string) *requestURL {
+	u := c.newURL(append([]string{"services", c.serviceCode, environmentName}, segments...)...)
+	u.check(ValidateEnvironmentName(environmentName))
+	return u
+}
+
+//with Set the query parameter key
+func (u *requestURL) with(key string, value string) *requestURL {
+	u.query.Set(key, value)
+	return u
+}
+
+//withId Set the query parameter key to an object ID
+func (u *requestURL) withId(key string, id string) *requestURL {
+	u.check(ValidateId(key, id))
+	return u.with(key, id)
+}
+
+//withOrg Set the org_id query parameter
+func (u *requestURL) withOrg(organizationId string) *requestURL {
+	u.check(ValidateOrganizationId(organizationId))
+	return u.with("org_id", organizationId)
+}
+
+//check Keep the first validation error
+func (u *requestURL) check(err error) {
+	if u.err == nil {
+		u.err = err
+	}
+}
+
+func (u *requestURL) String() string {
+	var b strings.Builder
+	b.WriteString(u.base)
+	for _, segment := range u.segments {
+		b.WriteString("/")
+		b.WriteString(url.PathEscape(segment))
+	}
+	if len(u.query) > 0 {
+		b.WriteString("?")
+		b.WriteString(u.query.Encode())
+	}
+	return b.String()
+}
+
+//wrapped The data envelope the API returns objects in
+type wrapped[T any] struct {
+	Data T `json:"data"`
+}
+
+//newRequest Build a request to u with body encoded as JSON, nil for none
+func (c *Client) newRequest(ctx context.Context, method string, u *requestURL, body interface{}) (*http.Request, error) {
+	if u.err != nil {
+		return nil, u.err
+	}
+	if body == nil {
+		return http.NewRequestWithContext(ctx, method, u.String(), nil)
+	}
+
+	jsonBytes, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	request, err := http.NewRequestWithContext(ctx, method, u.String(), bytes.NewReader(jsonBytes))
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	return request, nil
+}
+
+//decode Execute the request and decode the response into a T
+func decode[T any](c *Client, request *http.Request) (*T, error) {
+	respBytes, err := c.doRequest(request)
+	if err != nil {
+		return nil, err
+	}
+
+	var result T
+	err = json.Unmarshal(respBytes, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+//send Send a request with body encoded as JSON, nil for none, and decode the response into a T
+func send[T any](ctx context.Context, c *Client, method string, u *requestURL, body interface{}) (*T, error) {
+	request, err := c.newRequest(ctx, method, u, body)
+	if err != nil {
+		return nil, err
+	}
+	return decode[T](c, request)
+}
+
+//unwrap The object of a data envelope
+func unwrap[T any](wrappedAPIStruct *wrapped[T], err error) (*T, error) {
+	if err != nil {
+		return nil, err
+	}
+	return &wrappedAPIStruct.Data, nil
+}
+
+//get Get the object at u
+func get[T any](ctx context.Context, c *Client, u *requestURL) (*T, error) {
+	return unwrap(send[wrapped[T]](ctx, c, "GET", u, nil))
+}
+
+//list Get every page of the collection at u
+func list[T any](ctx context.Context, c *Client, u *requestURL) ([]T, error) {
+	return newPageIterator[T](ctx, c, u).all()
+}
+
+//create Create an object the API returns right away
+func create[T any](ctx context.Context, c *Client, u *requestURL, body interface{}) (*T, error) {
+	return unwrap(send[wrapped[T]](ctx, c, "POST", u, body))
+}
+
+//update Send body to u with method and return the updated object
+func update[T any](ctx context.Context, c *Client, method string, u *requestURL, body interface{}) (*T, error) {
+	return unwrap(send[wrapped[T]](ctx, c, method, u, body))
+}
+
+//createTask Create an object through a task
+func createTask(ctx context.Context, c *Client, u *requestURL, body interface{}) (*TaskStatusResponse, error) {
+	return send[TaskStatusResponse](ctx, c, "POST", u, body)
+}
+
+//updateTask Send body to u with method and return the task that applies it
+func updateTask(ctx context.Context, c *Client, method string, u *requestURL, body interface{}) (*TaskStatusResponse, error) {
+	return send[TaskStatusResponse](ctx, c, method, u, body)
+}
+
+//remove Delete the object at u
+func remove(ctx context.Context, c *Client, u *requestURL) error {
+	request, err := c.newRequest(ctx, "DELETE", u, nil)
+	if err != nil {
+		return err
+	}
+	_, err = c.doRequest(request)
+	return err
+}
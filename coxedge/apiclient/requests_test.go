@@ -0,0 +1,76 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+package apiclient
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestRequestURL(t *testing.T) {
+	client := NewClient("test-key", "https://portal.example.com/api/v2", "edge-services")
+
+	testCases := []struct {
+		name   string
+		url    *requestURL
+		expect string
+	}{
+		{
+			name:   "plain",
+			url:    client.newURL("users", "123"),
+			expect: "https://portal.example.com/api/v2/users/123",
+		},
+		{
+			name:   "service",
+			url:    client.serviceURL("prod", "sites").with("org_id", "abc"),
+			expect: "https://portal.example.com/api/v2/services/edge-services/prod/sites?org_id=abc",
+		},
+		{
+			name:   "escaped segments",
+			url:    client.serviceURL("my env", "sites", "a/b?c"),
+			expect: "https://portal.example.com/api/v2/services/edge-services/my%20env/sites/a%2Fb%3Fc",
+		},
+		{
+			name:   "escaped query",
+			url:    client.serviceURL("prod", "sites", "1").with("operation", "a&b=c d"),
+			expect: "https://portal.example.com/api/v2/services/edge-services/prod/sites/1?operation=a%26b%3Dc+d",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.url.String(); got != tc.expect {
+				t.Errorf("expected %s, got %s", tc.expect, got)
+			}
+		})
+	}
+}
+
+func TestSendEncodesBody(t *testing.T) {
+	var contentType, body string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		contentType = r.Header.Get("Content-Type")
+		bodyBytes, _ := ioutil.ReadAll(r.Body)
+		body = string(bodyBytes)
+		w.Write([]byte(`{"data":{"id":"1","name":"created"}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-key", server.URL, "")
+	environment, err := create[Environment](context.TODO(), &client, client.newURL("environments"), map[string]string{"name": "created"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if environment.Name != "created" {
+		t.Errorf("expected the created environment to be unwrapped, got %+v", environment)
+	}
+	if contentType != "application/json" || body != `{"name":"created"}` {
+		t.Errorf("unexpected request %s %s", contentType, body)
+	}
+}
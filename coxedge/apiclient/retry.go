@@ -0,0 +1,107 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+package apiclient
+
+import (
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const DefaultRetryMaxAttempts = 4
+const DefaultRetryMinBackoff = 1 * time.Second
+const DefaultRetryMaxBackoff = 30 * time.Second
+const DefaultRetryMaxRetryAfter = 2 * time.Minute
+
+//RetryPolicy Controls how doRequest retries transient failures
+type RetryPolicy struct {
+	//MaxAttempts Total number of attempts, including the first one. Values below 1 disable retries.
+	MaxAttempts int
+	//MinBackoff Base wait before the first retry, doubled on every following retry
+	MinBackoff time.Duration
+	//MaxBackoff Upper bound of the computed wait between attempts
+	MaxBackoff time.Duration
+	//MaxRetryAfter Longest Retry-After of the portal that is waited for. A longer one is ignored and the backoff used
+	//instead, so a broken header cannot stall a run.
+	MaxRetryAfter time.Duration
+}
+
+//DefaultRetryPolicy The policy used by NewClient
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:   DefaultRetryMaxAttempts,
+		MinBackoff:    DefaultRetryMinBackoff,
+		MaxBackoff:    DefaultRetryMaxBackoff,
+		MaxRetryAfter: DefaultRetryMaxRetryAfter,
+	}
+}
+
+//isIdempotent Only idempotent verbs may be retried, a POST could be carried out twice
+func isIdempotent(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+//isRetryableStatus Status codes that indicate a transient failure on the portal side
+func isRetryableStatus(statusCode int) bool {
+	switch statusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+//backoff Jittered exponential wait before the given retry (1 based)
+func (p RetryPolicy) backoff(retry int) time.Duration {
+	wait := p.MinBackoff
+	for i := 1; i < retry && wait < p.MaxBackoff; i++ {
+		wait *= 2
+	}
+	if p.MaxBackoff > 0 && wait > p.MaxBackoff {
+		wait = p.MaxBackoff
+	}
+	if wait <= 0 {
+		return 0
+	}
+	//Equal jitter: keep half of the wait and randomize the other half
+	half := wait / 2
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
+
+//wait Wait before the given retry (1 based): the longer of the backoff and the portal's Retry-After, unless that is
+//above MaxRetryAfter
+func (p RetryPolicy) wait(retry int, retryAfter time.Duration) time.Duration {
+	wait := p.backoff(retry)
+	if retryAfter > wait && retryAfter <= p.MaxRetryAfter {
+		wait = retryAfter
+	}
+	return wait
+}
+
+//parseRetryAfter Reads a Retry-After header given either in seconds or as an HTTP date
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		wait := date.Sub(now)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+	return 0, false
+}
@@ -0,0 +1,203 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+package apiclient
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func newRetryTestClient(url string) Client {
+	client := NewClient("test-key", url, "")
+	client.RetryPolicy = RetryPolicy{MaxAttempts: 3, MinBackoff: time.Millisecond, MaxBackoff: 5 * time.Millisecond, MaxRetryAfter: 2 * time.Second}
+	return client
+}
+
+func TestDoRequestRetriesTransientFailures(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		if string(body) != "payload" {
+			t.Errorf("got body %q", body)
+		}
+		if atomic.AddInt32(&calls, 1) < 3 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	client := newRetryTestClient(server.URL)
+	request, _ := http.NewRequest("PUT", server.URL, bytes.NewReader([]byte("payload")))
+	respBytes, err := client.doRequest(request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(respBytes) != "ok" || calls != 3 {
+		t.Errorf("got %q after %d calls", respBytes, calls)
+	}
+}
+
+func TestDoRequestGivesUpAfterMaxAttempts(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client := newRetryTestClient(server.URL)
+	request, _ := http.NewRequest("GET", server.URL, nil)
+	if _, err := client.doRequest(request); err == nil {
+		t.Error("expected an error")
+	}
+	if calls != 3 {
+		t.Errorf("expected 3 calls, got %d", calls)
+	}
+}
+
+func TestDoRequestDoesNotRetryPost(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client := newRetryTestClient(server.URL)
+	request, _ := http.NewRequest("POST", server.URL, nil)
+	client.doRequest(request)
+	if calls != 1 {
+		t.Errorf("expected 1 call for a POST, got %d", calls)
+	}
+
+	calls = 0
+	client.UpdateSite(context.TODO(), "site-1", "env-1", "disable", "1b3c5d7e-0000-4000-8000-00000000000a")
+	if calls != 1 {
+		t.Errorf("expected 1 call for a site operation, got %d", calls)
+	}
+}
+
+func TestDoRequestDoesNotRetryClientErrors(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	client := newRetryTestClient(server.URL)
+	request, _ := http.NewRequest("GET", server.URL, nil)
+	client.doRequest(request)
+	if calls != 1 {
+		t.Errorf("expected 1 call, got %d", calls)
+	}
+}
+
+func TestDoRequestHonorsRetryAfter(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	client := newRetryTestClient(server.URL)
+	request, _ := http.NewRequest("GET", server.URL, nil)
+	start := time.Now()
+	if _, err := client.doRequest(request); err != nil {
+		t.Fatal(err)
+	}
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Errorf("expected to wait for Retry-After, waited %s", elapsed)
+	}
+}
+
+func TestDoRequestIgnoresRetryAfterAboveLimit(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.Header().Set("Retry-After", "3600")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	client := newRetryTestClient(server.URL)
+	request, _ := http.NewRequest("GET", server.URL, nil)
+	start := time.Now()
+	if _, err := client.doRequest(request); err != nil {
+		t.Fatal(err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("expected the backoff instead of an hour long Retry-After, waited %s", elapsed)
+	}
+}
+
+func TestRetryPolicyWait(t *testing.T) {
+	policy := RetryPolicy{MinBackoff: time.Second, MaxBackoff: time.Second, MaxRetryAfter: time.Minute}
+	testCases := []struct {
+		name       string
+		retryAfter time.Duration
+		min        time.Duration
+		max        time.Duration
+	}{
+		{name: "no Retry-After", retryAfter: 0, min: 500 * time.Millisecond, max: time.Second},
+		{name: "Retry-After below the backoff", retryAfter: time.Millisecond, min: 500 * time.Millisecond, max: time.Second},
+		{name: "Retry-After", retryAfter: 30 * time.Second, min: 30 * time.Second, max: 30 * time.Second},
+		{name: "Retry-After at the limit", retryAfter: time.Minute, min: time.Minute, max: time.Minute},
+		{name: "Retry-After above the limit", retryAfter: time.Hour, min: 500 * time.Millisecond, max: time.Second},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if wait := policy.wait(1, tc.retryAfter); wait < tc.min || wait > tc.max {
+				t.Errorf("expected a wait between %s and %s, got %s", tc.min, tc.max, wait)
+			}
+		})
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	cases := map[string]time.Duration{
+		"5":                             5 * time.Second,
+		"Sat, 01 Jan 2022 00:00:10 GMT": 10 * time.Second,
+		"Fri, 31 Dec 2021 23:59:00 GMT": 0,
+	}
+	for value, expected := range cases {
+		wait, ok := parseRetryAfter(value, now)
+		if !ok || wait != expected {
+			t.Errorf("%q: got %s, %v", value, wait, ok)
+		}
+	}
+	if _, ok := parseRetryAfter("soon", now); ok {
+		t.Error("expected an invalid value to be ignored")
+	}
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	policy := RetryPolicy{MaxAttempts: 10, MinBackoff: time.Second, MaxBackoff: 4 * time.Second}
+	for retry := 1; retry <= 6; retry++ {
+		wait := policy.backoff(retry)
+		if wait < 500*time.Millisecond || wait > 4*time.Second {
+			t.Errorf("retry %d: backoff %s out of range", retry, wait)
+		}
+	}
+}
@@ -1,26 +1,38 @@
 package apiclient
 
 import (
-	"encoding/json"
-	"net/http"
+	"context"
 )
 
+type RoleCreateRequest struct {
+	Name           string       `json:"name"`
+	Description    string       `json:"description,omitempty"`
+	DefaultScope   string       `json:"defaultScope,omitempty"`
+	Permissions    []string     `json:"permissions"`
+	OrganizationId IdOnlyHelper `json:"organization,omitempty"`
+}
+
 //GetRoles Get organizations in account
-func (c *Client) GetRoles() ([]Roles, error) {
-	request, err := http.NewRequest("GET", CoxEdgeAPIBase+"/roles", nil)
-	if err != nil {
-		return nil, err
-	}
+func (c *Client) GetRoles(ctx context.Context) ([]Roles, error) {
+	return list[Roles](ctx, c, c.newURL("roles"))
+}
+
+//GetRole Get role in account by id
+func (c *Client) GetRole(ctx context.Context, id string) (*Roles, error) {
+	return get[Roles](ctx, c, c.newURL("roles", id))
+}
+
+//CreateRole Create the role
+func (c *Client) CreateRole(ctx context.Context, newRole RoleCreateRequest) (*Roles, error) {
+	return create[Roles](ctx, c, c.newURL("roles"), newRole)
+}
 
-	respBytes, err := c.doRequest(request)
-	if err != nil {
-		return nil, err
-	}
+//UpdateRole Update a role
+func (c *Client) UpdateRole(ctx context.Context, roleId string, newRole RoleCreateRequest) (*Roles, error) {
+	return update[Roles](ctx, c, "PUT", c.newURL("roles", roleId), newRole)
+}
 
-	var wrappedAPIStruct WrappedRolesData
-	err = json.Unmarshal(respBytes, &wrappedAPIStruct)
-	if err != nil {
-		return nil, err
-	}
-	return wrappedAPIStruct.Data, nil
+//DeleteRole Delete role in account by id
+func (c *Client) DeleteRole(ctx context.Context, id string) error {
+	return remove(ctx, c, c.newURL("roles", id))
 }
@@ -0,0 +1,65 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+package apiclient
+
+import (
+	"context"
+	"testing"
+)
+
+func TestGetRoles(t *testing.T) {
+	roles, err := apiClient.GetRoles(context.TODO())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(roles) == 0 {
+		t.Error("Got 0 roles")
+	}
+	t.Logf("Got %d Roles\n", len(roles))
+}
+
+func TestRoleLifecycle(t *testing.T) {
+	newRole, err := apiClient.CreateRole(context.TODO(), RoleCreateRequest{
+		Name:           "test-role",
+		DefaultScope:   "ENVIRONMENT",
+		Permissions:    []string{"environments:view"},
+		OrganizationId: IdOnlyHelper{Id: fakeServer.OrganizationId},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if newRole.Id == "" || newRole.IsSystem {
+		t.Errorf("unexpected role %+v", newRole)
+	}
+
+	updated, err := apiClient.UpdateRole(context.TODO(), newRole.Id, RoleCreateRequest{
+		Name:           "test-role",
+		DefaultScope:   "ENVIRONMENT",
+		Permissions:    []string{"environments:view", "environments:manage"},
+		OrganizationId: IdOnlyHelper{Id: fakeServer.OrganizationId},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(updated.Permissions) != 2 {
+		t.Errorf("expected two permissions, got %v", updated.Permissions)
+	}
+
+	role, err := apiClient.GetRole(context.TODO(), newRole.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if role.Name != "test-role" || role.Organization.Id != fakeServer.OrganizationId {
+		t.Errorf("unexpected role %+v", role)
+	}
+
+	if err = apiClient.DeleteRole(context.TODO(), newRole.Id); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = apiClient.GetRole(context.TODO(), newRole.Id); !IsNotFound(err) {
+		t.Errorf("expected the role to be gone, got %v", err)
+	}
+}
@@ -6,9 +6,7 @@
 package apiclient
 
 import (
-	"bytes"
-	"encoding/json"
-	"net/http"
+	"context"
 )
 
 type ScriptCreateRequest struct {
@@ -17,118 +15,46 @@ type ScriptCreateRequest struct {
 	Code   string   `json:"code,omitempty"`
 }
 
-//GetScripts Get Scripts in account
-func (c *Client) GetScripts(siteId string, environmentName string, organizationId string) ([]Script, error) {
-	request, err := http.NewRequest("GET",
-		CoxEdgeAPIBase+"/services/"+CoxEdgeServiceCode+"/"+environmentName+"/scripts?siteId="+siteId+"&org_id="+organizationId, nil)
-	if err != nil {
-		return nil, err
-	}
-
-	respBytes, err := c.doRequest(request)
-	if err != nil {
-		return nil, err
-	}
+//GetScripts Get Scripts in account, reading every page
+func (c *Client) GetScripts(ctx context.Context, siteId string, environmentName string, organizationId string) ([]Script, error) {
+	return c.ScriptsPages(ctx, siteId, environmentName, organizationId).all()
+}
 
-	var wrappedAPIStruct WrappedScripts
-	err = json.Unmarshal(respBytes, &wrappedAPIStruct)
-	if err != nil {
-		return nil, err
-	}
-	return wrappedAPIStruct.Data, nil
+//ScriptsPages Iterate Scripts in account one page at a time
+func (c *Client) ScriptsPages(ctx context.Context, siteId string, environmentName string, organizationId string) *PageIterator[Script] {
+	return newPageIterator[Script](ctx, c, c.serviceURL(environmentName, "scripts").withId("siteId", siteId).withOrg(organizationId))
 }
 
 //GetScript Get Script in account by id
-func (c *Client) GetScript(id string, siteId string, environmentName string, organizationId string) (*Script, error) {
-	//Create the request
-	request, err := http.NewRequest("GET",
-		CoxEdgeAPIBase+"/services/"+CoxEdgeServiceCode+"/"+environmentName+"/scripts/"+id+"?siteId="+siteId+"&org_id="+organizationId, nil)
-	if err != nil {
-		return nil, err
-	}
-
-	//Execute request
-	respBytes, err := c.doRequest(request)
-	if err != nil {
-		return nil, err
-	}
-
-	//Unmarshal, unwrap, and return
-	var wrappedAPIStruct WrappedScript
-	err = json.Unmarshal(respBytes, &wrappedAPIStruct)
-	if err != nil {
-		return nil, err
-	}
-	return &wrappedAPIStruct.Data, nil
+func (c *Client) GetScript(ctx context.Context, id string, siteId string, environmentName string, organizationId string) (*Script, error) {
+	script, ok, err := cachedGet(c, scriptsKey(environmentName, siteId, organizationId), id,
+		func() ([]Script, error) { return c.GetScripts(ctx, siteId, environmentName, organizationId) },
+		func(script Script) string { return script.Id },
+	)
+	if ok || err != nil {
+		return script, err
+	}
+	return get[Script](ctx, c, c.serviceURL(environmentName, "scripts", id).withId("siteId", siteId).withOrg(organizationId))
 }
 
 //CreateScript Create the Script
-func (c *Client) CreateScript(siteId string, environmentName string, newScript ScriptCreateRequest, organizationId string) (*TaskStatusResponse, error) {
-	//Marshal the request
-	jsonBytes, err := json.Marshal(newScript)
-	if err != nil {
-		return nil, err
-	}
-	//Wrap bytes in reader
-	bReader := bytes.NewReader(jsonBytes)
-	//Create the request
-	request, err := http.NewRequest("POST",
-		CoxEdgeAPIBase+"/services/"+CoxEdgeServiceCode+"/"+environmentName+"/scripts?siteId="+siteId+"&org_id="+organizationId, bReader)
-	request.Header.Set("Content-Type", "application/json")
-	//Execute request
-	respBytes, err := c.doRequest(request)
-	if err != nil {
-		return nil, err
-	}
-	//Return struct
-	var wrappedAPIStruct TaskStatusResponse
-	err = json.Unmarshal(respBytes, &wrappedAPIStruct)
-	if err != nil {
-		return nil, err
-	}
-	return &wrappedAPIStruct, nil
+func (c *Client) CreateScript(ctx context.Context, siteId string, environmentName string, newScript ScriptCreateRequest, organizationId string) (*TaskStatusResponse, error) {
+	c.listCache.invalidate(scriptsKey(environmentName, siteId, organizationId), "")
+	return createTask(ctx, c, c.serviceURL(environmentName, "scripts").withId("siteId", siteId).withOrg(organizationId), newScript)
 }
 
 //UpdateScript Update a Script
-func (c *Client) UpdateScript(id string, siteId string, environmentName string, newScript ScriptCreateRequest, organizationId string) (*TaskStatusResponse, error) {
-	//Marshal the request
-	jsonBytes, err := json.Marshal(newScript)
-	if err != nil {
-		return nil, err
-	}
-	//Wrap bytes in reader
-	bReader := bytes.NewReader(jsonBytes)
-	//Create the request
-	request, err := http.NewRequest("PUT",
-		CoxEdgeAPIBase+"/services/"+CoxEdgeServiceCode+"/"+environmentName+"/scripts/"+id+"?siteId="+siteId+"&org_id="+organizationId, bReader)
-	request.Header.Set("Content-Type", "application/json")
-	//Execute request
-	respBytes, err := c.doRequest(request)
-	if err != nil {
-		return nil, err
-	}
-	//Return struct
-	var wrappedAPIStruct TaskStatusResponse
-	err = json.Unmarshal(respBytes, &wrappedAPIStruct)
-	if err != nil {
-		return nil, err
-	}
-	return &wrappedAPIStruct, nil
+func (c *Client) UpdateScript(ctx context.Context, id string, siteId string, environmentName string, newScript ScriptCreateRequest, organizationId string) (*TaskStatusResponse, error) {
+	c.listCache.invalidate(scriptsKey(environmentName, siteId, organizationId), id)
+	return updateTask(ctx, c, "PUT", c.serviceURL(environmentName, "scripts", id).withId("siteId", siteId).withOrg(organizationId), newScript)
 }
 
 //DeleteScript Delete Script in account by id
-func (c *Client) DeleteScript(id string, siteId string, environmentName string, organizationId string) error {
-	//Create the request
-	request, err := http.NewRequest("DELETE",
-		CoxEdgeAPIBase+"/services/"+CoxEdgeServiceCode+"/"+environmentName+"/scripts/"+id+"?siteId="+siteId+"&org_id="+organizationId, nil)
-	if err != nil {
-		return err
-	}
+func (c *Client) DeleteScript(ctx context.Context, id string, siteId string, environmentName string, organizationId string) error {
+	c.listCache.invalidate(scriptsKey(environmentName, siteId, organizationId), id)
+	return remove(ctx, c, c.serviceURL(environmentName, "scripts", id).withId("siteId", siteId).withOrg(organizationId))
+}
 
-	//Execute request
-	_, err = c.doRequest(request)
-	if err != nil {
-		return err
-	}
-	return nil
+func scriptsKey(environmentName string, siteId string, organizationId string) listCacheKey {
+	return listCacheKey{kind: "scripts", environmentName: environmentName, siteId: siteId, organizationId: organizationId}
 }
@@ -0,0 +1,54 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+package apiclient
+
+import (
+	"context"
+	"coxedge/terraform-provider/coxedge/apiclient/fake"
+	"testing"
+)
+
+func TestScriptLifecycle(t *testing.T) {
+	siteId := createTestSite(t, "scripts.cc.com")
+	newScript := ScriptCreateRequest{
+		Name:   "redirect",
+		Routes: []string{"/old/*"},
+		Code:   "addEventListener('fetch', e => e.respondWith(fetch(e.request)))",
+	}
+	taskResp, err := apiClient.CreateScript(context.TODO(), siteId, fake.EnvironmentName, newScript, fakeServer.OrganizationId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	scriptId := awaitTask(t, taskResp.TaskId).Data.Result.Id
+
+	scripts, err := apiClient.GetScripts(context.TODO(), siteId, fake.EnvironmentName, fakeServer.OrganizationId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(scripts) != 1 || scripts[0].SiteId != siteId {
+		t.Errorf("unexpected scripts %+v", scripts)
+	}
+
+	newScript.Routes = []string{"/new/*"}
+	taskResp, err = apiClient.UpdateScript(context.TODO(), scriptId, siteId, fake.EnvironmentName, newScript, fakeServer.OrganizationId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	awaitTask(t, taskResp.TaskId)
+
+	script, err := apiClient.GetScript(context.TODO(), scriptId, siteId, fake.EnvironmentName, fakeServer.OrganizationId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(script.Routes) != 1 || script.Routes[0] != "/new/*" {
+		t.Errorf("unexpected routes %v", script.Routes)
+	}
+
+	err = apiClient.DeleteScript(context.TODO(), scriptId, siteId, fake.EnvironmentName, fakeServer.OrganizationId)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -1,18 +1,88 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
 package apiclient
 
-import "testing"
+import (
+	"context"
+	"coxedge/terraform-provider/coxedge/apiclient/fake"
+	"testing"
+)
 
-func TestCreateSite(t *testing.T) {
+//createTestSite Create a site in the seeded environment and wait for it
+func createTestSite(t *testing.T, domain string) string {
 	newSite := SiteCreateRequest{
-		EnvironmentName: "test-codecraft",
-		Domain:          "www.cc.com",
+		EnvironmentName: fake.EnvironmentName,
+		Domain:          domain,
 		Hostname:        "199.250.204.212",
 		Protocol:        "HTTPS",
 		Services:        []string{"CDN", "SERVERLESS_EDGE_ENGINE", "WAF"},
 	}
-	res, err := apiClient.CreateSite(newSite)
+	res, err := apiClient.CreateSite(context.TODO(), newSite, fakeServer.OrganizationId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return awaitTask(t, res.TaskId).Data.Result.Id
+}
+
+func TestCreateSite(t *testing.T) {
+	siteId := createTestSite(t, "www.cc.com")
+
+	site, err := apiClient.GetSite(context.TODO(), fake.EnvironmentName, siteId, fakeServer.OrganizationId)
 	if err != nil {
-		t.Error(err)
+		t.Fatal(err)
+	}
+	if site.Domain != "www.cc.com" || len(site.Services) != 3 {
+		t.Errorf("unexpected site %+v", site)
+	}
+	t.Logf("created %v", site.Id)
+}
+
+func TestGetSites(t *testing.T) {
+	createTestSite(t, "list.cc.com")
+	sites, err := apiClient.GetSites(context.TODO(), fake.EnvironmentName, fakeServer.OrganizationId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sites) == 0 {
+		t.Error("Got 0 sites")
+	}
+}
+
+func TestUpdateSite(t *testing.T) {
+	siteId := createTestSite(t, "update.cc.com")
+	res, err := apiClient.UpdateSite(context.TODO(), siteId, fake.EnvironmentName, "disable", fakeServer.OrganizationId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	awaitTask(t, res.TaskId)
+
+	site, err := apiClient.GetSite(context.TODO(), fake.EnvironmentName, siteId, fakeServer.OrganizationId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if site.Status != "DISABLED" {
+		t.Errorf("expected the site to be disabled, got %q", site.Status)
+	}
+}
+
+func TestDeleteSite(t *testing.T) {
+	siteId := createTestSite(t, "delete.cc.com")
+	err := apiClient.DeleteSite(context.TODO(), fake.EnvironmentName, siteId, fakeServer.OrganizationId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = apiClient.GetSite(context.TODO(), fake.EnvironmentName, siteId, fakeServer.OrganizationId)
+	if !IsNotFound(err) {
+		t.Errorf("expected the site to be gone, got %v", err)
+	}
+}
+
+func TestGetSiteInUnknownEnvironment(t *testing.T) {
+	_, err := apiClient.GetSites(context.TODO(), "missing-env", fakeServer.OrganizationId)
+	if !IsNotFound(err) {
+		t.Errorf("expected a 404, got %v", err)
 	}
-	t.Logf("created %v", res.TaskStatus)
 }
@@ -6,9 +6,7 @@
 package apiclient
 
 import (
-	"bytes"
-	"encoding/json"
-	"net/http"
+	"context"
 )
 
 type SiteCreateRequest struct {
@@ -22,121 +20,35 @@ type SiteCreateRequest struct {
 	Password        string   `json:"password,omitempty"`
 }
 
-//GetSites Get sites in account
-func (c *Client) GetSites(environmentName string, organizationId string) ([]Site, error) {
-	request, err := http.NewRequest("GET",
-		CoxEdgeAPIBase+"/services/"+CoxEdgeServiceCode+"/"+environmentName+"/sites?org_id="+organizationId,
-		nil,
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	respBytes, err := c.doRequest(request)
-	if err != nil {
-		return nil, err
-	}
+//GetSites Get sites in account, reading every page
+func (c *Client) GetSites(ctx context.Context, environmentName string, organizationId string) ([]Site, error) {
+	return c.SitesPages(ctx, environmentName, organizationId).all()
+}
 
-	var wrappedAPIStruct WrappedSites
-	err = json.Unmarshal(respBytes, &wrappedAPIStruct)
-	if err != nil {
-		return nil, err
-	}
-	return wrappedAPIStruct.Data, nil
+//SitesPages Iterate sites in account one page at a time
+func (c *Client) SitesPages(ctx context.Context, environmentName string, organizationId string) *PageIterator[Site] {
+	return newPageIterator[Site](ctx, c, c.serviceURL(environmentName, "sites").withOrg(organizationId))
 }
 
 //GetSite Get site in account by id
-func (c *Client) GetSite(environmentName string, id string, organizationId string) (*Site, error) {
-	//Create the request
-	request, err := http.NewRequest("GET",
-		CoxEdgeAPIBase+"/services/"+CoxEdgeServiceCode+"/"+environmentName+"/sites/"+id+"?org_id="+organizationId,
-		nil,
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	//Execute request
-	respBytes, err := c.doRequest(request)
-	if err != nil {
-		return nil, err
-	}
-
-	//Unmarshal, unwrap, and return
-	var wrappedAPIStruct WrappedSite
-	err = json.Unmarshal(respBytes, &wrappedAPIStruct)
-	if err != nil {
-		return nil, err
-	}
-	return &wrappedAPIStruct.Data, nil
+func (c *Client) GetSite(ctx context.Context, environmentName string, id string, organizationId string) (*Site, error) {
+	return get[Site](ctx, c, c.serviceURL(environmentName, "sites", id).withOrg(organizationId))
 }
 
 //CreateSite Create the site
-func (c *Client) CreateSite(newSite SiteCreateRequest, organizationId string) (*TaskStatusResponse, error) {
-	//Marshal the request
-	jsonBytes, err := json.Marshal(newSite)
-	if err != nil {
-		return nil, err
-	}
-	//Wrap bytes in reader
-	bReader := bytes.NewReader(jsonBytes)
-	//Create the request
-	request, err := http.NewRequest("POST",
-		CoxEdgeAPIBase+"/services/"+CoxEdgeServiceCode+"/"+newSite.EnvironmentName+"/sites?org_id="+organizationId,
-		bReader,
-	)
-	request.Header.Set("Content-Type", "application/json")
-	//Execute request
-	respBytes, err := c.doRequest(request)
-	if err != nil {
-		return nil, err
-	}
-	//Return struct
-	var wrappedAPIStruct TaskStatusResponse
-	err = json.Unmarshal(respBytes, &wrappedAPIStruct)
-	if err != nil {
-		return nil, err
-	}
-	return &wrappedAPIStruct, nil
+func (c *Client) CreateSite(ctx context.Context, newSite SiteCreateRequest, organizationId string) (*TaskStatusResponse, error) {
+	return createTask(ctx, c, c.serviceURL(newSite.EnvironmentName, "sites").withOrg(organizationId), newSite)
 }
 
 //UpdateSite Update a site
-func (c *Client) UpdateSite(siteId string, environmentName string, operationValue string, organizationId string) (*TaskStatusResponse, error) {
-	//Create the request
-	request, err := http.NewRequest("POST",
-		CoxEdgeAPIBase+"/services/"+CoxEdgeServiceCode+"/"+environmentName+"/sites/"+siteId+"?org_id="+organizationId+"&operation="+operationValue,
+func (c *Client) UpdateSite(ctx context.Context, siteId string, environmentName string, operationValue string, organizationId string) (*TaskStatusResponse, error) {
+	return send[TaskStatusResponse](ctx, c, "POST",
+		c.serviceURL(environmentName, "sites", siteId).withOrg(organizationId).with("operation", operationValue),
 		nil,
 	)
-	request.Header.Set("Content-Type", "application/json")
-	//Execute request
-	respBytes, err := c.doRequest(request)
-	if err != nil {
-		return nil, err
-	}
-	//Return struct
-	var wrappedAPIStruct TaskStatusResponse
-	err = json.Unmarshal(respBytes, &wrappedAPIStruct)
-	if err != nil {
-		return nil, err
-	}
-	return &wrappedAPIStruct, nil
 }
 
 //DeleteSite Delete site in account by id
-func (c *Client) DeleteSite(environmentName string, id string, organizationId string) error {
-	//Create the request
-	request, err := http.NewRequest("DELETE",
-		CoxEdgeAPIBase+"/services/"+CoxEdgeServiceCode+"/"+environmentName+"/sites/"+id+"?org_id="+organizationId,
-		nil,
-	)
-	if err != nil {
-		return err
-	}
-
-	//Execute request
-	_, err = c.doRequest(request)
-	if err != nil {
-		return err
-	}
-	return nil
+func (c *Client) DeleteSite(ctx context.Context, environmentName string, id string, organizationId string) error {
+	return remove(ctx, c, c.serviceURL(environmentName, "sites", id).withOrg(organizationId))
 }
@@ -7,11 +7,8 @@ package apiclient
 
 import (
 	"context"
-	"encoding/json"
-	"errors"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"net/http"
 	"time"
 )
 
@@ -19,68 +16,66 @@ const TaskSuccess = "SUCCESS"
 const TaskFailed = "FAILURE"
 const TaskPending = "PENDING"
 
-func (c *Client) GetTaskStatus(taskId string) (*TaskStatus, error) {
-	request, err := http.NewRequest("GET", CoxEdgeAPIBase+"/tasks/"+taskId, nil)
-	request.Header.Set("Content-Type", "application/json")
-	//Execute request
-	respBytes, err := c.doRequest(request)
-	if err != nil {
-		return nil, err
-	}
-	//Return struct
-	var wrappedAPIStruct TaskStatus
-	fmt.Println(string(respBytes))
-	err = json.Unmarshal(respBytes, &wrappedAPIStruct)
-	if err != nil {
-		return nil, err
-	}
-	return &wrappedAPIStruct, nil
+const DefaultTaskTimeout = 10 * time.Minute
+const TaskPollMinInterval = 1 * time.Second
+const TaskPollMaxInterval = 15 * time.Second
+
+func (c *Client) GetTaskStatus(ctx context.Context, taskId string) (*TaskStatus, error) {
+	return send[TaskStatus](ctx, c, "GET", c.newURL("tasks", taskId), nil)
 }
 
-func (c *Client) AwaitTaskResolve(upstreamCtx context.Context, taskId string, attemptCount int, interval time.Duration, timeout time.Duration) (*TaskStatus, error) {
-	//Let's create a content
-	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+//AwaitTaskResolve Poll the task until it resolves or the timeout expires. The wait between polls starts at
+//minInterval and doubles up to maxInterval. Cancellation and deadlines of ctx are honored.
+func (c *Client) AwaitTaskResolve(ctx context.Context, taskId string, minInterval time.Duration, maxInterval time.Duration, timeout time.Duration) (*TaskStatus, error) {
+	//Bound the wait by the timeout while keeping the upstream cancellation
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	//Create channels for data
-	errChan := make(chan error)
-	dataChan := make(chan TaskStatus)
-
-	go func() {
-		tflog.Info(upstreamCtx, "Waiting on Resource to Create.")
-		for i := 0; i < attemptCount; i++ {
-			taskRes, err := c.GetTaskStatus(taskId)
-			if err != nil {
-				errChan <- err
-				break
-			}
+	tflog.Info(ctx, "Waiting on Resource to Create.")
+	interval := minInterval
+	for {
+		taskRes, err := c.GetTaskStatus(ctx, taskId)
+		if err != nil {
+			return nil, wrapTaskTimeout(ctx, err, taskId, timeout)
+		}
 
-			tflog.Info(upstreamCtx, "Status of task is "+taskRes.Data.TaskStatus)
+		tflog.Info(ctx, "Status of task is "+taskRes.Data.TaskStatus)
 
-			//Check to see if we are done
-			if taskRes.Data.TaskStatus == TaskFailed {
-				errChan <- errors.New("task failed")
-				break
-			} else if taskRes.Data.TaskStatus == TaskSuccess {
-				dataChan <- *taskRes
-				break
-			} else {
-				time.Sleep(interval)
-			}
+		//Check to see if we are done
+		if taskRes.Data.TaskStatus == TaskFailed {
+			return taskRes, newTaskFailedError(taskId, taskRes)
+		} else if taskRes.Data.TaskStatus == TaskSuccess {
+			return taskRes, nil
+		}
 
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, wrapTaskTimeout(ctx, ctx.Err(), taskId, timeout)
+		case <-timer.C:
 		}
-	}()
 
-	select {
-	case err := <-errChan:
-		return nil, err
-	case res := <-dataChan:
-		return &res, nil
-	case <-ctx.Done():
-		return nil, ctx.Err()
+		interval *= 2
+		if interval > maxInterval {
+			interval = maxInterval
+		}
 	}
 }
 
+//AwaitTaskResolveWithTimeout Poll the task with the default intervals
+func (c *Client) AwaitTaskResolveWithTimeout(ctx context.Context, taskId string, timeout time.Duration) (*TaskStatus, error) {
+	return c.AwaitTaskResolve(ctx, taskId, TaskPollMinInterval, TaskPollMaxInterval, timeout)
+}
+
 func (c *Client) AwaitTaskResolveWithDefaults(ctx context.Context, taskId string) (*TaskStatus, error) {
-	return c.AwaitTaskResolve(ctx, taskId, 100, 5*time.Second, 10*time.Minute)
+	return c.AwaitTaskResolveWithTimeout(ctx, taskId, DefaultTaskTimeout)
+}
+
+//wrapTaskTimeout Give deadline errors a message that names the task
+func wrapTaskTimeout(ctx context.Context, err error, taskId string, timeout time.Duration) error {
+	if ctx.Err() == context.DeadlineExceeded {
+		return fmt.Errorf("timed out after %s waiting for task %s: %w", timeout, taskId, err)
+	}
+	return err
 }
@@ -0,0 +1,100 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+package apiclient
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestAwaitTaskResolveHonorsCancellation(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"data":{"id":"task-1","status":"PENDING"}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-key", server.URL, "")
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(50*time.Millisecond, cancel)
+
+	start := time.Now()
+	_, err := client.AwaitTaskResolve(ctx, "task-1", time.Second, time.Second, time.Minute)
+	if err != context.Canceled {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("cancellation took %s", elapsed)
+	}
+}
+
+func TestAwaitTaskResolveBacksOffUntilSuccess(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) < 4 {
+			w.Write([]byte(`{"data":{"id":"task-1","status":"PENDING"}}`))
+			return
+		}
+		w.Write([]byte(`{"data":{"id":"task-1","status":"SUCCESS","result":{"id":"workload-1"}}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-key", server.URL, "")
+	start := time.Now()
+	res, err := client.AwaitTaskResolve(context.Background(), "task-1", 10*time.Millisecond, 20*time.Millisecond, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Data.Result.Id != "workload-1" {
+		t.Errorf("unexpected result %+v", res.Data.Result)
+	}
+	//Waits are 10ms, 20ms and then capped at 20ms
+	if elapsed := time.Since(start); elapsed < 50*time.Millisecond {
+		t.Errorf("expected backoff between polls, finished in %s", elapsed)
+	}
+}
+
+func TestAwaitTaskResolveTimesOut(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"data":{"id":"task-1","status":"PENDING"}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-key", server.URL, "")
+	_, err := client.AwaitTaskResolve(context.Background(), "task-1", 10*time.Millisecond, 10*time.Millisecond, 50*time.Millisecond)
+	if !errors.Is(err, context.DeadlineExceeded) || !strings.Contains(err.Error(), "task-1") {
+		t.Errorf("expected a timeout naming the task, got %v", err)
+	}
+}
+
+func TestAwaitTaskResolveReportsFailureDetails(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"data":{"id":"task-1","status":"FAILURE","failureReason":"Deployment failed","errorCode":422,"messages":["Quota exceeded"],"errors":[{"code":"VOLUME","message":"Volume too large"}]}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-key", server.URL, "")
+	_, err := client.AwaitTaskResolve(context.Background(), "task-1", time.Millisecond, time.Millisecond, time.Minute)
+
+	var taskErr *TaskFailedError
+	if !errors.As(err, &taskErr) {
+		t.Fatalf("expected a *TaskFailedError, got %v", err)
+	}
+	if taskErr.TaskId != "task-1" || taskErr.Reason != "Deployment failed" || taskErr.ErrorCode != "422" {
+		t.Errorf("unexpected error %+v", taskErr)
+	}
+	if len(taskErr.Messages) != 2 || taskErr.Messages[0] != "Quota exceeded" || taskErr.Messages[1] != "Volume too large" {
+		t.Errorf("unexpected messages %v", taskErr.Messages)
+	}
+	if err.Error() != "task task-1 failed: Deployment failed (code 422), messages: Quota exceeded; Volume too large" {
+		t.Errorf("unexpected message %q", err.Error())
+	}
+}
@@ -0,0 +1,90 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+package apiclient
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+//DefaultRequestTimeout Limit on a whole request used by NewClient
+const DefaultRequestTimeout = 10 * time.Second
+
+//DefaultUserAgent User-Agent used by NewClient
+const DefaultUserAgent = "terraform-provider-coxedge"
+
+//TransportConfig Settings of the HTTP client built by NewHTTPClient
+type TransportConfig struct {
+	//Timeout Limit on a whole request, reading the response body included. 0 means no limit.
+	Timeout time.Duration
+	//ProxyURL Proxy of every request. Empty uses the HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables.
+	ProxyURL string
+	//CABundleFile PEM file of certificate authorities trusted on top of the system ones
+	CABundleFile string
+	//TLSMinVersion Lowest accepted TLS version, e.g. tls.VersionTLS12. 0 keeps the Go default.
+	TLSMinVersion uint16
+	//InsecureSkipVerify Accept any server certificate. Only meant for labs.
+	InsecureSkipVerify bool
+}
+
+//NewHTTPClient Build an HTTP client with its own transport, so its connections are reused by every request of the
+//client it is given to
+func NewHTTPClient(config TransportConfig) (*http.Client, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	if config.ProxyURL != "" {
+		proxyURL, err := url.Parse(config.ProxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy URL %q: %w", config.ProxyURL, err)
+		}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion:         config.TLSMinVersion,
+		InsecureSkipVerify: config.InsecureSkipVerify,
+	}
+	if config.CABundleFile != "" {
+		pem, err := ioutil.ReadFile(config.CABundleFile)
+		if err != nil {
+			return nil, fmt.Errorf("reading CA bundle: %w", err)
+		}
+		rootCAs, err := x509.SystemCertPool()
+		if err != nil || rootCAs == nil {
+			rootCAs = x509.NewCertPool()
+		}
+		if !rootCAs.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no PEM certificates found in CA bundle %s", config.CABundleFile)
+		}
+		tlsConfig.RootCAs = rootCAs
+	}
+	transport.TLSClientConfig = tlsConfig
+
+	return &http.Client{
+		Timeout:   config.Timeout,
+		Transport: transport,
+	}, nil
+}
+
+//ParseTLSVersion The tls package constant of a version such as "1.2"
+func ParseTLSVersion(version string) (uint16, error) {
+	switch version {
+	case "1.0":
+		return tls.VersionTLS10, nil
+	case "1.1":
+		return tls.VersionTLS11, nil
+	case "1.2":
+		return tls.VersionTLS12, nil
+	case "1.3":
+		return tls.VersionTLS13, nil
+	}
+	return 0, fmt.Errorf("unsupported TLS version %q, expected 1.0, 1.1, 1.2 or 1.3", version)
+}
@@ -0,0 +1,146 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+package apiclient
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func newTLSTestServer(t *testing.T) (*httptest.Server, string) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"data":[]}`))
+	}))
+	t.Cleanup(server.Close)
+
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	certificate := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	if err := os.WriteFile(caFile, certificate, 0600); err != nil {
+		t.Fatal(err)
+	}
+	return server, caFile
+}
+
+func getOrganizationsWith(t *testing.T, url string, config TransportConfig) error {
+	httpClient, err := NewHTTPClient(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := NewClient("test-key", url, "")
+	client.HTTPClient = httpClient
+	client.RetryPolicy = RetryPolicy{MaxAttempts: 1}
+	_, err = client.GetOrganizations(context.Background())
+	return err
+}
+
+func TestNewHTTPClientTLS(t *testing.T) {
+	server, caFile := newTLSTestServer(t)
+
+	testCases := []struct {
+		name        string
+		config      TransportConfig
+		expectError bool
+	}{
+		{
+			name:        "unknown authority",
+			config:      TransportConfig{},
+			expectError: true,
+		},
+		{
+			name:   "CA bundle",
+			config: TransportConfig{CABundleFile: caFile},
+		},
+		{
+			name:   "insecure skip verify",
+			config: TransportConfig{InsecureSkipVerify: true},
+		},
+		{
+			name:   "TLS minimum version met",
+			config: TransportConfig{CABundleFile: caFile, TLSMinVersion: tls.VersionTLS12},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := getOrganizationsWith(t, server.URL, tc.config)
+			if (err != nil) != tc.expectError {
+				t.Errorf("expected error %t, got %v", tc.expectError, err)
+			}
+		})
+	}
+}
+
+func TestNewHTTPClientTLSMinVersion(t *testing.T) {
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"data":[]}`))
+	}))
+	server.TLS = &tls.Config{MaxVersion: tls.VersionTLS12}
+	server.StartTLS()
+	defer server.Close()
+
+	err := getOrganizationsWith(t, server.URL, TransportConfig{InsecureSkipVerify: true, TLSMinVersion: tls.VersionTLS13})
+	if err == nil {
+		t.Error("expected a server limited to TLS 1.2 to be refused")
+	}
+}
+
+func TestNewHTTPClientProxy(t *testing.T) {
+	var proxied string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		//A proxy receives the absolute URL of the target
+		proxied = r.URL.String()
+		w.Write([]byte(`{"data":[]}`))
+	}))
+	defer proxy.Close()
+
+	if err := getOrganizationsWith(t, "http://api.coxedge.invalid/api/v2", TransportConfig{ProxyURL: proxy.URL}); err != nil {
+		t.Fatal(err)
+	}
+	if proxied != "http://api.coxedge.invalid/api/v2/organizations?page=1&size=100" {
+		t.Errorf("expected the request to go through the proxy, proxy saw %q", proxied)
+	}
+}
+
+func TestNewHTTPClientInvalidSettings(t *testing.T) {
+	notPEM := filepath.Join(t.TempDir(), "ca.pem")
+	if err := os.WriteFile(notPEM, []byte("not a certificate"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	for name, config := range map[string]TransportConfig{
+		"missing CA bundle": {CABundleFile: filepath.Join(t.TempDir(), "missing.pem")},
+		"empty CA bundle":   {CABundleFile: notPEM},
+		"invalid proxy":     {ProxyURL: "http://proxy:port"},
+	} {
+		if _, err := NewHTTPClient(config); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
+
+func TestClientSendsUserAgent(t *testing.T) {
+	var userAgent string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userAgent = r.Header.Get("User-Agent")
+		w.Write([]byte(`{"data":[]}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-key", server.URL, "")
+	client.UserAgent = "Terraform/1.5.7 terraform-provider-coxedge/0.1"
+	if _, err := client.GetOrganizations(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if userAgent != client.UserAgent {
+		t.Errorf("expected User-Agent %q, got %q", client.UserAgent, userAgent)
+	}
+}
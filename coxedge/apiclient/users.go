@@ -6,9 +6,7 @@
 package apiclient
 
 import (
-	"bytes"
-	"encoding/json"
-	"net/http"
+	"context"
 )
 
 type UserCreateRequest struct {
@@ -20,129 +18,37 @@ type UserCreateRequest struct {
 	Roles          []IdOnlyHelper `json:"roles,omitempty"`
 }
 
-//GetUsers Get users in account
-func (c *Client) GetUsers() ([]User, error) {
-	request, err := http.NewRequest("GET", CoxEdgeAPIBase+"/users", nil)
-	if err != nil {
-		return nil, err
-	}
-
-	respBytes, err := c.doRequest(request)
-	if err != nil {
-		return nil, err
-	}
+//GetUsers Get users in account, reading every page
+func (c *Client) GetUsers(ctx context.Context) ([]User, error) {
+	return c.UsersPages(ctx).all()
+}
 
-	var wrappedAPIStruct WrappedUsers
-	err = json.Unmarshal(respBytes, &wrappedAPIStruct)
-	if err != nil {
-		return nil, err
-	}
-	return wrappedAPIStruct.Data, nil
+//UsersPages Iterate users in account one page at a time
+func (c *Client) UsersPages(ctx context.Context) *PageIterator[User] {
+	return newPageIterator[User](ctx, c, c.newURL("users"))
 }
 
 //GetUser Get user in account by id
-func (c *Client) GetUser(id string) (*User, error) {
-	//Create the request
-	request, err := http.NewRequest("GET", CoxEdgeAPIBase+"/users/"+id, nil)
-	if err != nil {
-		return nil, err
-	}
-
-	//Execute request
-	respBytes, err := c.doRequest(request)
-	if err != nil {
-		return nil, err
-	}
-
-	//Unmarshal, unwrap, and return
-	var wrappedAPIStruct WrappedUser
-	err = json.Unmarshal(respBytes, &wrappedAPIStruct)
-	if err != nil {
-		return nil, err
-	}
-	return &wrappedAPIStruct.Data, nil
+func (c *Client) GetUser(ctx context.Context, id string) (*User, error) {
+	return get[User](ctx, c, c.newURL("users", id))
 }
 
 //CreateUser Create the user
-func (c *Client) CreateUser(newUser UserCreateRequest) (*User, error) {
-	//Marshal the request
-	jsonBytes, err := json.Marshal(newUser)
-	if err != nil {
-		return nil, err
-	}
-	//Wrap bytes in reader
-	bReader := bytes.NewReader(jsonBytes)
-	//Create the request
-	request, err := http.NewRequest("POST", CoxEdgeAPIBase+"/users", bReader)
-	request.Header.Set("Content-Type", "application/json")
-	//Execute request
-	respBytes, err := c.doRequest(request)
-	if err != nil {
-		return nil, err
-	}
-	//Return struct
-	var wrappedAPIStruct WrappedUser
-	err = json.Unmarshal(respBytes, &wrappedAPIStruct)
-	if err != nil {
-		return nil, err
-	}
-	return &wrappedAPIStruct.Data, nil
+func (c *Client) CreateUser(ctx context.Context, newUser UserCreateRequest) (*User, error) {
+	return create[User](ctx, c, c.newURL("users"), newUser)
 }
 
 //UpdateUser Update a user
-func (c *Client) UpdateUser(userId string, newUser UserCreateRequest) (*User, error) {
-	//Marshal the request
-	jsonBytes, err := json.Marshal(newUser)
-	if err != nil {
-		return nil, err
-	}
-	//Wrap bytes in reader
-	bReader := bytes.NewReader(jsonBytes)
-	//Create the request
-	request, err := http.NewRequest("PUT", CoxEdgeAPIBase+"/users/"+userId, bReader)
-	request.Header.Set("Content-Type", "application/json")
-	//Execute request
-	respBytes, err := c.doRequest(request)
-	if err != nil {
-		return nil, err
-	}
-	//Return struct
-	var wrappedAPIStruct WrappedUser
-	err = json.Unmarshal(respBytes, &wrappedAPIStruct)
-	if err != nil {
-		return nil, err
-	}
-	return &wrappedAPIStruct.Data, nil
+func (c *Client) UpdateUser(ctx context.Context, userId string, newUser UserCreateRequest) (*User, error) {
+	return update[User](ctx, c, "PUT", c.newURL("users", userId), newUser)
 }
 
 //DeleteUser Delete user in account by id
-func (c *Client) DeleteUser(id string) error {
-	//Create the request
-	request, err := http.NewRequest("DELETE", CoxEdgeAPIBase+"/users/"+id, nil)
-	if err != nil {
-		return err
-	}
-
-	//Execute request
-	_, err = c.doRequest(request)
-	if err != nil {
-		return err
-	}
-	return nil
+func (c *Client) DeleteUser(ctx context.Context, id string) error {
+	return remove(ctx, c, c.newURL("users", id))
 }
 
 //UnlockUser Unlock user in account by id
-func (c *Client) UnlockUser(id string) error {
-	//Create the request
-	request, err := http.NewRequest("DELETE", CoxEdgeAPIBase+"/users/"+id+"/unlock", nil)
-	if err != nil {
-		return err
-	}
-
-	//Execute request
-	_, err = c.doRequest(request)
-	if err != nil {
-		return err
-	}
-	return nil
+func (c *Client) UnlockUser(ctx context.Context, id string) error {
+	return remove(ctx, c, c.newURL("users", id, "unlock"))
 }
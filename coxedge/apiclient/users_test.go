@@ -6,70 +6,100 @@
 package apiclient
 
 import (
-	"net/http"
+	"context"
 	"testing"
 )
 
-var firstUserId string
-var createdUserId string
+//createTestUser Create a user in the organization of the fake
+func createTestUser(t *testing.T, userName string) *User {
+	user := UserCreateRequest{
+		UserName:       userName,
+		FirstName:      "Test",
+		LastName:       "User",
+		Email:          userName + "@harpooncorp.io",
+		OrganizationId: IdOnlyHelper{Id: fakeServer.OrganizationId},
+	}
+	newUser, err := apiClient.CreateUser(context.TODO(), user)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return newUser
+}
 
 func TestGetUsers(t *testing.T) {
-	orgs, err := apiClient.GetUsers()
+	users, err := apiClient.GetUsers(context.TODO())
 	if err != nil {
-		t.Error(err)
+		t.Fatal(err)
 	}
-	if len(orgs) == 0 {
+	if len(users) == 0 {
 		t.Error("Got 0 users")
 	}
-
-	firstUserId = orgs[0].Id
-	t.Logf("Got %d Users\n", len(orgs))
+	t.Logf("Got %d Users\n", len(users))
 }
 
 func TestGetUser(t *testing.T) {
-	org, err := apiClient.GetUser(firstUserId)
+	newUser := createTestUser(t, "testuser-get")
+	user, err := apiClient.GetUser(context.TODO(), newUser.Id)
 	if err != nil {
-		t.Error(err)
+		t.Fatal(err)
+	}
+	if user.UserName != "testuser-get" || user.Status != "ACTIVE" {
+		t.Errorf("unexpected user %+v", user)
 	}
-	t.Logf("Got user with ID: %s\n", org.Id)
 }
 
 func TestUserCreate(t *testing.T) {
-	user := UserCreateRequest{
-		UserName:       "testuser",
-		FirstName:      "Test",
-		LastName:       "User",
-		Email:          "testuser@harpooncorp.io",
-		OrganizationId: IdOnlyHelper{Id: firstOrgId},
+	newUser := createTestUser(t, "testuser")
+	if newUser.Id == "" || newUser.Organization.Id != fakeServer.OrganizationId {
+		t.Errorf("unexpected user %+v", newUser)
 	}
+	t.Logf("Created user with ID: %s\n", newUser.Id)
+}
 
-	newUser, err := apiClient.CreateUser(user)
+func TestUserUpdate(t *testing.T) {
+	newUser := createTestUser(t, "testuser-update")
+	updated, err := apiClient.UpdateUser(context.TODO(), newUser.Id, UserCreateRequest{
+		UserName:  "testuser-update",
+		FirstName: "Renamed",
+		LastName:  "User",
+		Email:     newUser.Email,
+	})
 	if err != nil {
-		t.Error(err)
+		t.Fatal(err)
+	}
+	if updated.FirstName != "Renamed" {
+		t.Errorf("unexpected user %+v", updated)
 	}
-	createdUserId = newUser.Id
-	t.Logf("Created user with ID: %s\n", createdUserId)
 }
 
 func TestUserDelete(t *testing.T) {
-	err := apiClient.DeleteUser(createdUserId)
+	newUser := createTestUser(t, "testuser-delete")
+	err := apiClient.DeleteUser(context.TODO(), newUser.Id)
 	if err != nil {
-		t.Error(err)
+		t.Fatal(err)
+	}
+	_, err = apiClient.GetUser(context.TODO(), newUser.Id)
+	if !IsNotFound(err) {
+		t.Errorf("expected the user to be gone, got %v", err)
 	}
 }
 
-//TestUnlockUser Unlock user in account by id
-func (c *Client) TestUnlockUser(id string) error {
-	//Create the request
-	request, err := http.NewRequest("POST", CoxEdgeAPIBase+"/users/"+id+"/unlock", nil)
+func TestUnlockUser(t *testing.T) {
+	newUser := createTestUser(t, "testuser-unlock")
+	fakeServer.LockUser(newUser.Id)
+
+	err := apiClient.UnlockUser(context.TODO(), newUser.Id)
 	if err != nil {
-		return err
+		t.Fatal(err)
 	}
-
-	//Execute request
-	_, err = c.doRequest(request)
+	user, err := apiClient.GetUser(context.TODO(), newUser.Id)
 	if err != nil {
-		return err
+		t.Fatal(err)
+	}
+	if user.Status != "ACTIVE" {
+		t.Errorf("expected the user to be unlocked, got status %q", user.Status)
+	}
+	if err = apiClient.UnlockUser(context.TODO(), "missing-user"); !IsNotFound(err) {
+		t.Errorf("expected a not found error for a missing user, got %v", err)
 	}
-	return nil
 }
@@ -0,0 +1,51 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+package apiclient
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+var organizationIdPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+var environmentNamePattern = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]*$`)
+
+//ValidateOrganizationId Check that id is an organization UUID
+func ValidateOrganizationId(id string) error {
+	if !organizationIdPattern.MatchString(id) {
+		return &ValidationError{Field: "organization ID", Value: id, Reason: "expected a UUID"}
+	}
+	return nil
+}
+
+//ValidateEnvironmentName Check that name is an environment name: letters, digits, '-', '_' and '.', starting with a
+//letter or a digit
+func ValidateEnvironmentName(name string) error {
+	if !environmentNamePattern.MatchString(name) {
+		return &ValidationError{
+			Field:  "environment name",
+			Value:  name,
+			Reason: "expected letters, digits, '-', '_' and '.', starting with a letter or a digit",
+		}
+	}
+	return nil
+}
+
+//ValidateId Check that id can name an object: not empty, without whitespace or control characters and not a
+//relative path such as ".."
+func ValidateId(field string, id string) error {
+	switch {
+	case id == "":
+		return &ValidationError{Field: field, Value: id, Reason: "must not be empty"}
+	case id == "." || id == "..":
+		return &ValidationError{Field: field, Value: id, Reason: "must not be a relative path"}
+	case strings.IndexFunc(id, func(r rune) bool { return unicode.IsSpace(r) || unicode.IsControl(r) }) >= 0:
+		return &ValidationError{Field: field, Value: id, Reason: "must not contain whitespace or control characters"}
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+package apiclient
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	testCases := []struct {
+		name        string
+		err         error
+		expectError bool
+	}{
+		{name: "organization UUID", err: ValidateOrganizationId("1b3c5d7e-0000-4000-8000-00000000000a")},
+		{name: "organization name", err: ValidateOrganizationId("my-org"), expectError: true},
+		{name: "empty organization", err: ValidateOrganizationId(""), expectError: true},
+		{name: "organization with query", err: ValidateOrganizationId("1b3c5d7e-0000-4000-8000-00000000000a&x=y"), expectError: true},
+		{name: "environment name", err: ValidateEnvironmentName("prod-env_2.1")},
+		{name: "environment with space", err: ValidateEnvironmentName("prod env"), expectError: true},
+		{name: "environment with slash", err: ValidateEnvironmentName("prod/env"), expectError: true},
+		{name: "environment starting with dash", err: ValidateEnvironmentName("-env"), expectError: true},
+		{name: "empty environment", err: ValidateEnvironmentName(""), expectError: true},
+		{name: "id", err: ValidateId("ID", "00000000-0000-4000-8000-000000000001")},
+		{name: "image id part", err: ValidateId("ID", "centos-7:v201905241424")},
+		{name: "empty id", err: ValidateId("ID", ""), expectError: true},
+		{name: "parent id", err: ValidateId("ID", ".."), expectError: true},
+		{name: "id with space", err: ValidateId("ID", "a b"), expectError: true},
+		{name: "id with newline", err: ValidateId("ID", "a\nb"), expectError: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if (tc.err != nil) != tc.expectError {
+				t.Errorf("expected error %t, got %v", tc.expectError, tc.err)
+			}
+			if tc.err != nil && !IsValidationError(tc.err) {
+				t.Errorf("expected a ValidationError, got %T", tc.err)
+			}
+		})
+	}
+}
+
+func TestInvalidArgumentsAreNotSent(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Write([]byte(`{"data":{}}`))
+	}))
+	defer server.Close()
+	client := NewClient("test-key", server.URL, "")
+	orgId := "1b3c5d7e-0000-4000-8000-00000000000a"
+
+	calls := map[string]func() error{
+		"environment with space": func() error {
+			_, err := client.GetSite(context.TODO(), "prod env", "site-1", orgId)
+			return err
+		},
+		"organization name": func() error {
+			_, err := client.GetWorkload(context.TODO(), "prod", "workload-1", "my-org")
+			return err
+		},
+		"empty id": func() error {
+			return client.DeleteSite(context.TODO(), "prod", "", orgId)
+		},
+		"empty site id": func() error {
+			_, err := client.GetScripts(context.TODO(), "", "prod", orgId)
+			return err
+		},
+		"relative user id": func() error {
+			_, err := client.GetUser(context.TODO(), "..")
+			return err
+		},
+	}
+	for name, call := range calls {
+		if err := call(); !IsValidationError(err) {
+			t.Errorf("%s: expected a ValidationError, got %v", name, err)
+		}
+	}
+	if requests != 0 {
+		t.Errorf("expected no request to be sent, got %d", requests)
+	}
+}
@@ -6,62 +6,18 @@
 package apiclient
 
 import (
-	"bytes"
-	"encoding/json"
-	"net/http"
+	"context"
 )
 
 //GetWAFSettings Get wafSettings in account by id
-func (c *Client) GetWAFSettings(environmentName string, id string, organizationId string) (*WAFSettings, error) {
-	//Create the request
-	request, err := http.NewRequest("GET",
-		CoxEdgeAPIBase+"/services/"+CoxEdgeServiceCode+"/"+environmentName+"/wafsettings/"+id+"?org_id="+organizationId,
-		nil,
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	//Execute request
-	respBytes, err := c.doRequest(request)
-	if err != nil {
-		return nil, err
-	}
-
-	//Unmarshal, unwrap, and return
-	var wrappedAPIStruct WrappedWAFSettings
-	err = json.Unmarshal(respBytes, &wrappedAPIStruct)
-	if err != nil {
-		return nil, err
-	}
-	return &wrappedAPIStruct.Data, nil
+func (c *Client) GetWAFSettings(ctx context.Context, environmentName string, id string, organizationId string) (*WAFSettings, error) {
+	return get[WAFSettings](ctx, c, c.serviceURL(environmentName, "wafsettings", id).withOrg(organizationId))
 }
 
 //UpdateWAFSettings Update a wafSettings
-func (c *Client) UpdateWAFSettings(wafSettingsId string, newWAFSettings WAFSettings, organizationId string) (*TaskStatusResponse, error) {
-	//Marshal the request
-	jsonBytes, err := json.Marshal(newWAFSettings)
-	if err != nil {
-		return nil, err
-	}
-	//Wrap bytes in reader
-	bReader := bytes.NewReader(jsonBytes)
-	//Create the request
-	request, err := http.NewRequest("PATCH",
-		CoxEdgeAPIBase+"/services/"+CoxEdgeServiceCode+"/"+newWAFSettings.EnvironmentName+"/wafsettings/"+wafSettingsId+"?org_id="+organizationId,
-		bReader,
+func (c *Client) UpdateWAFSettings(ctx context.Context, wafSettingsId string, newWAFSettings WAFSettings, organizationId string) (*TaskStatusResponse, error) {
+	return updateTask(ctx, c, "PATCH",
+		c.serviceURL(newWAFSettings.EnvironmentName, "wafsettings", wafSettingsId).withOrg(organizationId),
+		newWAFSettings,
 	)
-	request.Header.Set("Content-Type", "application/json")
-	//Execute request
-	respBytes, err := c.doRequest(request)
-	if err != nil {
-		return nil, err
-	}
-	//Return struct
-	var wrappedAPIStruct TaskStatusResponse
-	err = json.Unmarshal(respBytes, &wrappedAPIStruct)
-	if err != nil {
-		return nil, err
-	}
-	return &wrappedAPIStruct, nil
 }
@@ -1,25 +1,36 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
 package apiclient
 
 import (
 	"context"
+	"coxedge/terraform-provider/coxedge/apiclient/fake"
 	"testing"
 )
 
 func TestUpdateWAFSettings(t *testing.T) {
+	siteId := createTestSite(t, "waf.cc.com")
 	var c bool
 	b := true
 	new := WAFSettings{
-		EnvironmentName: "test-codecraft",
+		EnvironmentName: fake.EnvironmentName,
 		OwaspThreats: WAFOwaspThreats{SQLInjection: &b,
 			XSSAttack: &c}}
-	taskResp, err := apiClient.UpdateWAFSettings("352cdc1e-c071-49ad-bddd-371094880507", new)
+	taskResp, err := apiClient.UpdateWAFSettings(context.TODO(), siteId, new, fakeServer.OrganizationId)
 	if err != nil {
-		t.Error(err)
+		t.Fatal(err)
 	}
-	taskResult, err := apiClient.AwaitTaskResolveWithDefaults(context.TODO(), taskResp.TaskId)
+	taskResult := awaitTask(t, taskResp.TaskId)
+	t.Logf("Got %v wafsettings", taskResult.Data)
+
+	settings, err := apiClient.GetWAFSettings(context.TODO(), fake.EnvironmentName, siteId, fakeServer.OrganizationId)
 	if err != nil {
-		t.Error(err)
+		t.Fatal(err)
+	}
+	if settings.OwaspThreats.SQLInjection == nil || !*settings.OwaspThreats.SQLInjection {
+		t.Errorf("unexpected waf settings %+v", settings.OwaspThreats)
 	}
-	//fmt.Println(taskResult)
-	t.Logf("Got %v wafsettings", taskResult.Data)
 }
@@ -1,26 +1,9 @@
 package apiclient
 
 import (
-	"encoding/json"
-	"net/http"
+	"context"
 )
 
-func (c *Client) GetWorkloadInstances(environmentName string, organizationId string, workloadId string) ([]WorkloadInstance, error) {
-	request, err := http.NewRequest("GET",
-		CoxEdgeAPIBase+"/services/"+CoxEdgeServiceCode+"/"+environmentName+"/instances?workloadId="+workloadId+"&org_id="+organizationId,
-		nil)
-	if err != nil {
-		return nil, err
-	}
-	respBytes, err := c.doRequest(request)
-	if err != nil {
-		return nil, err
-	}
-
-	var wrappedAPIStruct WrapperWorkloadInstances
-	err = json.Unmarshal(respBytes, &wrappedAPIStruct)
-	if err != nil {
-		return nil, err
-	}
-	return wrappedAPIStruct.Data, nil
+func (c *Client) GetWorkloadInstances(ctx context.Context, environmentName string, organizationId string, workloadId string) ([]WorkloadInstance, error) {
+	return list[WorkloadInstance](ctx, c, c.serviceURL(environmentName, "instances").withId("workloadId", workloadId).withOrg(organizationId))
 }
@@ -6,9 +6,7 @@
 package apiclient
 
 import (
-	"bytes"
-	"encoding/json"
-	"net/http"
+	"context"
 )
 
 type WorkloadCreateRequest struct {
@@ -34,128 +32,32 @@ type WorkloadCreateRequest struct {
 	Slug                          string                        `json:"slug,omitempty"`
 }
 
-//GetWorkloads Get workloads in account
-func (c *Client) GetWorkloads(environmentName string, organizationId string) ([]Workload, error) {
-	request, err := http.NewRequest("GET",
-		CoxEdgeAPIBase+"/services/"+CoxEdgeServiceCode+"/"+environmentName+"/workloads?org_id="+organizationId,
-		nil,
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	respBytes, err := c.doRequest(request)
-	if err != nil {
-		return nil, err
-	}
+//GetWorkloads Get workloads in account, reading every page
+func (c *Client) GetWorkloads(ctx context.Context, environmentName string, organizationId string) ([]Workload, error) {
+	return c.WorkloadsPages(ctx, environmentName, organizationId).all()
+}
 
-	var wrappedAPIStruct WrappedWorkloads
-	err = json.Unmarshal(respBytes, &wrappedAPIStruct)
-	if err != nil {
-		return nil, err
-	}
-	return wrappedAPIStruct.Data, nil
+//WorkloadsPages Iterate workloads in account one page at a time
+func (c *Client) WorkloadsPages(ctx context.Context, environmentName string, organizationId string) *PageIterator[Workload] {
+	return newPageIterator[Workload](ctx, c, c.serviceURL(environmentName, "workloads").withOrg(organizationId))
 }
 
 //GetWorkload Get workload in account by id
-func (c *Client) GetWorkload(environmentName string, id string, organizationId string) (*Workload, error) {
-	//Create the request
-	request, err := http.NewRequest("GET",
-		CoxEdgeAPIBase+"/services/"+CoxEdgeServiceCode+"/"+environmentName+"/workloads/"+id+"?org_id="+organizationId,
-		nil,
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	//Execute request
-	respBytes, err := c.doRequest(request)
-	if err != nil {
-		return nil, err
-	}
-
-	//Unmarshal, unwrap, and return
-	var wrappedAPIStruct WrappedWorkload
-	err = json.Unmarshal(respBytes, &wrappedAPIStruct)
-	if err != nil {
-		return nil, err
-	}
-	return &wrappedAPIStruct.Data, nil
+func (c *Client) GetWorkload(ctx context.Context, environmentName string, id string, organizationId string) (*Workload, error) {
+	return get[Workload](ctx, c, c.serviceURL(environmentName, "workloads", id).withOrg(organizationId))
 }
 
 //CreateWorkload Create the workload
-func (c *Client) CreateWorkload(newWorkload WorkloadCreateRequest, organizationId string) (*TaskStatusResponse, error) {
-	//Marshal the request
-	jsonBytes, err := json.Marshal(newWorkload)
-	if err != nil {
-		return nil, err
-	}
-	//Wrap bytes in reader
-	bReader := bytes.NewReader(jsonBytes)
-	//Create the request
-	request, err := http.NewRequest("POST",
-		CoxEdgeAPIBase+"/services/"+CoxEdgeServiceCode+"/"+newWorkload.EnvironmentName+"/workloads?org_id="+organizationId,
-		bReader,
-	)
-	request.Header.Set("Content-Type", "application/json")
-	//Execute request
-	respBytes, err := c.doRequest(request)
-	if err != nil {
-		return nil, err
-	}
-	//Return struct
-	var wrappedAPIStruct TaskStatusResponse
-	err = json.Unmarshal(respBytes, &wrappedAPIStruct)
-	if err != nil {
-		return nil, err
-	}
-	return &wrappedAPIStruct, nil
+func (c *Client) CreateWorkload(ctx context.Context, newWorkload WorkloadCreateRequest, organizationId string) (*TaskStatusResponse, error) {
+	return createTask(ctx, c, c.serviceURL(newWorkload.EnvironmentName, "workloads").withOrg(organizationId), newWorkload)
 }
 
 //UpdateWorkload Update a workload
-func (c *Client) UpdateWorkload(workloadId string, newWorkload WorkloadCreateRequest, organizationId string) (*TaskStatusResponse, error) {
-	//Marshal the request
-	jsonBytes, err := json.Marshal(newWorkload)
-	if err != nil {
-		return nil, err
-	}
-	//Wrap bytes in reader
-	bReader := bytes.NewReader(jsonBytes)
-	//Create the request
-	request, err := http.NewRequest("PUT",
-		CoxEdgeAPIBase+"/services/"+CoxEdgeServiceCode+"/"+newWorkload.EnvironmentName+"/workloads/"+workloadId+"?org_id="+organizationId,
-		bReader,
-	)
-	request.Header.Set("Content-Type", "application/json")
-	//Execute request
-	respBytes, err := c.doRequest(request)
-	if err != nil {
-		return nil, err
-	}
-	//Return struct
-	var wrappedAPIStruct TaskStatusResponse
-	err = json.Unmarshal(respBytes, &wrappedAPIStruct)
-	if err != nil {
-		return nil, err
-	}
-	return &wrappedAPIStruct, nil
+func (c *Client) UpdateWorkload(ctx context.Context, workloadId string, newWorkload WorkloadCreateRequest, organizationId string) (*TaskStatusResponse, error) {
+	return updateTask(ctx, c, "PUT", c.serviceURL(newWorkload.EnvironmentName, "workloads", workloadId).withOrg(organizationId), newWorkload)
 }
 
 //DeleteWorkload Delete workload in account by id
-func (c *Client) DeleteWorkload(environmentName string, id string, organizationId string) error {
-	//Create the request
-	request, err := http.NewRequest("DELETE",
-		CoxEdgeAPIBase+"/services/"+CoxEdgeServiceCode+"/"+environmentName+"/workloads/"+id+"?org_id="+organizationId,
-		nil,
-	)
-	if err != nil {
-		return err
-	}
-
-	//Execute request
-	_, err = c.doRequest(request)
-	if err != nil {
-		return err
-	}
-	return nil
+func (c *Client) DeleteWorkload(ctx context.Context, environmentName string, id string, organizationId string) error {
+	return remove(ctx, c, c.serviceURL(environmentName, "workloads", id).withOrg(organizationId))
 }
@@ -7,41 +7,16 @@ package apiclient
 
 import (
 	"context"
-	"fmt"
+	"coxedge/terraform-provider/coxedge/apiclient/fake"
+	"errors"
 	"testing"
+	"time"
 )
 
-var firstWorkloadId string
-
-func TestGetWorkloads(t *testing.T) {
-	items, err := apiClient.GetWorkloads("base-test-env")
-	if err != nil {
-		t.Error(err)
-	}
-	//if len(items) == 0 {
-	//	t.Error("Got 0 items")
-	//}
-
-	if len(items) > 0 {
-		firstWorkloadId = items[0].Id
-	}
-	t.Logf("Got %d Items\n", len(items))
-}
-
-func TestGetWorkload(t *testing.T) {
-	if firstWorkloadId != "" {
-		org, err := apiClient.GetWorkload("base-test-env", firstWorkloadId)
-		if err != nil {
-			t.Error(err)
-		}
-		t.Logf("Got workload with ID: %s\n", org.Id)
-	}
-}
-
-func TestCreateWorkload(t *testing.T) {
-	newWorkload := WorkloadCreateRequest{
-		EnvironmentName: "base-test-env",
-		Name:            "test2",
+func newTestWorkload(name string) WorkloadCreateRequest {
+	return WorkloadCreateRequest{
+		EnvironmentName: fake.EnvironmentName,
+		Name:            name,
 		Deployments: []WorkloadAutoscaleDeployment{
 			{
 				Name:               "test",
@@ -57,13 +32,113 @@ func TestCreateWorkload(t *testing.T) {
 		Specs: "SP-1",
 		Type:  "CONTAINER",
 	}
-	taskResp, err := apiClient.CreateWorkload(newWorkload)
+}
+
+//createTestWorkload Create a workload in the seeded environment and wait for it
+func createTestWorkload(t *testing.T, name string) string {
+	taskResp, err := apiClient.CreateWorkload(context.TODO(), newTestWorkload(name), fakeServer.OrganizationId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return awaitTask(t, taskResp.TaskId).Data.Result.Id
+}
+
+func TestGetWorkloads(t *testing.T) {
+	createTestWorkload(t, "test-list")
+	items, err := apiClient.GetWorkloads(context.TODO(), fake.EnvironmentName, fakeServer.OrganizationId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(items) == 0 {
+		t.Error("Got 0 items")
+	}
+	t.Logf("Got %d Items\n", len(items))
+}
+
+func TestGetWorkload(t *testing.T) {
+	workloadId := createTestWorkload(t, "test-get")
+	workload, err := apiClient.GetWorkload(context.TODO(), fake.EnvironmentName, workloadId, fakeServer.OrganizationId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if workload.Name != "test-get" || len(workload.Deployments) != 1 || workload.Deployments[0].InstancesPerPop != 1 {
+		t.Errorf("unexpected workload %+v", workload)
+	}
+}
+
+func TestCreateWorkload(t *testing.T) {
+	taskResp, err := apiClient.CreateWorkload(context.TODO(), newTestWorkload("test2"), fakeServer.OrganizationId)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	//The fake reports the task as pending first
+	taskStatus, err := apiClient.GetTaskStatus(context.TODO(), taskResp.TaskId)
 	if err != nil {
-		t.Error(err)
+		t.Fatal(err)
+	}
+	if taskStatus.Data.TaskStatus != TaskPending {
+		t.Errorf("expected a pending task, got %q", taskStatus.Data.TaskStatus)
+	}
+
+	taskResult := awaitTask(t, taskResp.TaskId)
+	if taskResult.Data.Result.Id == "" || taskResult.Data.Result.Name != "test2" {
+		t.Errorf("unexpected task result %+v", taskResult.Data.Result)
 	}
-	taskResult, err := apiClient.AwaitTaskResolveWithDefaults(context.TODO(), taskResp.TaskId)
+}
+
+func TestCreateWorkloadFailure(t *testing.T) {
+	fakeServer.FailNextTask("Insufficient capacity in MIA")
+	taskResp, err := apiClient.CreateWorkload(context.TODO(), newTestWorkload("test-fail"), fakeServer.OrganizationId)
 	if err != nil {
-		t.Error(err)
+		t.Fatal(err)
+	}
+	_, err = apiClient.AwaitTaskResolve(context.TODO(), taskResp.TaskId, time.Millisecond, time.Millisecond, time.Minute)
+
+	var taskErr *TaskFailedError
+	if !errors.As(err, &taskErr) || taskErr.Reason != "Insufficient capacity in MIA" {
+		t.Errorf("expected the failure reason, got %v", err)
+	}
+}
+
+func TestUpdateWorkload(t *testing.T) {
+	workloadId := createTestWorkload(t, "test-update")
+	updated := newTestWorkload("test-update")
+	updated.Specs = "SP-2"
+	taskResp, err := apiClient.UpdateWorkload(context.TODO(), workloadId, updated, fakeServer.OrganizationId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	awaitTask(t, taskResp.TaskId)
+
+	workload, err := apiClient.GetWorkload(context.TODO(), fake.EnvironmentName, workloadId, fakeServer.OrganizationId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if workload.Specs != "SP-2" {
+		t.Errorf("expected the new specs, got %q", workload.Specs)
+	}
+}
+
+func TestGetWorkloadInstances(t *testing.T) {
+	workloadId := createTestWorkload(t, "test-instances")
+	instances, err := apiClient.GetWorkloadInstances(context.TODO(), fake.EnvironmentName, fakeServer.OrganizationId, workloadId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(instances) != 1 || instances[0].WorkloadId != workloadId {
+		t.Errorf("unexpected instances %+v", instances)
+	}
+}
+
+func TestDeleteWorkload(t *testing.T) {
+	workloadId := createTestWorkload(t, "test-delete")
+	err := apiClient.DeleteWorkload(context.TODO(), fake.EnvironmentName, workloadId, fakeServer.OrganizationId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = apiClient.GetWorkload(context.TODO(), fake.EnvironmentName, workloadId, fakeServer.OrganizationId)
+	if !IsNotFound(err) {
+		t.Errorf("expected the workload to be gone, got %v", err)
 	}
-	fmt.Println(taskResult)
 }
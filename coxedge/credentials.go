@@ -0,0 +1,220 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+package coxedge
+
+import (
+	"bufio"
+	"context"
+	"coxedge/terraform-provider/coxedge/apiclient"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+//defaultSharedCredentialsFile Credentials file read when shared_credentials_file is not set
+const defaultSharedCredentialsFile = "~/.coxedge/credentials"
+
+//defaultProfile Profile used when profile is not set
+const defaultProfile = "default"
+
+//credentials What the provider authenticates and scopes its requests with
+type credentials struct {
+	Key             string
+	APIBaseURL      string
+	ServiceCode     string
+	OrganizationId  string
+	EnvironmentName string
+}
+
+//credentialsFileKeys Setting of each profile key in the credentials file
+var credentialsFileKeys = map[string]func(c *credentials) *string{
+	"key":              func(c *credentials) *string { return &c.Key },
+	"api_base_url":     func(c *credentials) *string { return &c.APIBaseURL },
+	"service_code":     func(c *credentials) *string { return &c.ServiceCode },
+	"organization_id":  func(c *credentials) *string { return &c.OrganizationId },
+	"environment_name": func(c *credentials) *string { return &c.EnvironmentName },
+}
+
+//resolveCredentials Resolve the credentials of the provider block. Each value comes from, in order of precedence:
+//  1. the provider argument, or its environment variable
+//  2. the selected profile of the shared credentials file
+//  3. the built-in default, for api_base_url and service_code
+//A missing credentials file is only skipped when neither the file nor a profile was set explicitly.
+func resolveCredentials(d *schema.ResourceData) (credentials, error) {
+	resolved := credentials{
+		Key:             d.Get("key").(string),
+		APIBaseURL:      d.Get("api_base_url").(string),
+		ServiceCode:     d.Get("service_code").(string),
+		OrganizationId:  d.Get("organization_id").(string),
+		EnvironmentName: d.Get("environment_name").(string),
+	}
+
+	profileName := d.Get("profile").(string)
+	explicitProfile := profileName != ""
+	if !explicitProfile {
+		profileName = defaultProfile
+	}
+	path := d.Get("shared_credentials_file").(string)
+	explicitFile := path != ""
+	if !explicitFile {
+		path = defaultSharedCredentialsFile
+	}
+
+	profiles, err := readCredentialsFile(path)
+	if os.IsNotExist(err) && !explicitFile && !explicitProfile {
+		return resolved, nil
+	}
+	if err != nil {
+		return resolved, err
+	}
+	profile, ok := profiles[profileName]
+	if !ok {
+		if explicitProfile {
+			return resolved, fmt.Errorf("profile %q not found in %s", profileName, path)
+		}
+		return resolved, nil
+	}
+
+	//Arguments take precedence over the profile
+	for _, field := range credentialsFileKeys {
+		if *field(&resolved) == "" {
+			*field(&resolved) = *field(&profile)
+		}
+	}
+	return resolved, nil
+}
+
+//readCredentialsFile Parse an INI style credentials file of named profiles, e.g.
+//  [production]
+//  key             = <api key>
+//  organization_id = <organization id>
+func readCredentialsFile(path string) (map[string]credentials, error) {
+	path, err := expandHome(path)
+	if err != nil {
+		return nil, err
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	profiles := map[string]credentials{}
+	profileName := ""
+	scanner := bufio.NewScanner(file)
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			profileName = strings.TrimSpace(line[1 : len(line)-1])
+			if _, ok := profiles[profileName]; !ok {
+				profiles[profileName] = credentials{}
+			}
+			continue
+		}
+		keyValue := strings.SplitN(line, "=", 2)
+		if len(keyValue) != 2 {
+			return nil, fmt.Errorf("%s:%d: expected key = value", path, lineNumber)
+		}
+		if profileName == "" {
+			return nil, fmt.Errorf("%s:%d: %s is not in a [profile] section", path, lineNumber, strings.TrimSpace(keyValue[0]))
+		}
+		field, ok := credentialsFileKeys[strings.TrimSpace(keyValue[0])]
+		if !ok {
+			return nil, fmt.Errorf("%s:%d: unknown key %q", path, lineNumber, strings.TrimSpace(keyValue[0]))
+		}
+		profile := profiles[profileName]
+		*field(&profile) = strings.TrimSpace(keyValue[1])
+		profiles[profileName] = profile
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return profiles, nil
+}
+
+//validateCredentials Make one authenticated call so a bad key fails configure with a clear diagnostic instead of an
+//opaque 401 from whichever resource refreshes first. It also checks the key can access the default organization.
+func validateCredentials(ctx context.Context, client apiclient.OrganizationsAPI, creds credentials) diag.Diagnostics {
+	//Call the API
+	organizations, err := client.GetOrganizations(ctx)
+	switch {
+	case apiclient.IsUnauthorized(err) || apiclient.IsForbidden(err):
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Invalid Cox Edge API key",
+				Detail: fmt.Sprintf("The Cox Edge API rejected the key: %s\n\n"+
+					"Check the key set with key, COXEDGE_KEY or the profile of the shared credentials file. It may be mistyped or revoked.", err),
+			},
+		}
+	case err != nil && errors.As(err, new(*apiclient.APIError)):
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Unable to validate the Cox Edge API key",
+				Detail:   fmt.Sprintf("Listing organizations failed: %s", err),
+			},
+		}
+	case err != nil:
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Cox Edge API unreachable",
+				Detail: fmt.Sprintf("The API key could not be validated, %s did not respond: %s\n\n"+
+					"Check api_base_url and the network. Set skip_credentials_validation to plan without reaching the API.", apiBaseURL(creds), err),
+			},
+		}
+	}
+
+	if creds.OrganizationId == "" {
+		return nil
+	}
+	var accessible []string
+	for _, organization := range organizations {
+		if organization.Id == creds.OrganizationId {
+			return nil
+		}
+		accessible = append(accessible, fmt.Sprintf("%s (%s)", organization.Id, organization.Name))
+	}
+	detail := fmt.Sprintf("The API key has no access to organization %s set on the provider.", creds.OrganizationId)
+	if len(accessible) > 0 {
+		detail += " It can access: " + strings.Join(accessible, ", ")
+	}
+	return diag.Diagnostics{
+		diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "No access to the Cox Edge organization",
+			Detail:   detail,
+		},
+	}
+}
+
+//apiBaseURL The API base URL the client is created with
+func apiBaseURL(creds credentials) string {
+	if creds.APIBaseURL == "" {
+		return apiclient.CoxEdgeAPIBase
+	}
+	return creds.APIBaseURL
+}
+
+//expandHome Expand a leading ~ to the home directory of the user
+func expandHome(path string) (string, error) {
+	if path != "~" && !strings.HasPrefix(path, "~/") {
+		return path, nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, path[1:]), nil
+}
@@ -0,0 +1,258 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+package coxedge
+
+import (
+	"context"
+	"coxedge/terraform-provider/coxedge/apiclient"
+	"coxedge/terraform-provider/coxedge/apiclient/mock"
+	"errors"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const testCredentialsFile = `
+# Cox Edge credentials
+[default]
+key             = default-key
+organization_id = default-org
+
+[uat]
+key              = uat-key
+api_base_url     = https://cox.uat.cloudmc.io/api/v2
+service_code     = stackpath-cox-uat
+organization_id  = uat-org
+environment_name = uat-env
+`
+
+func writeTestCredentialsFile(t *testing.T, content string) string {
+	path := filepath.Join(t.TempDir(), "credentials")
+	if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+//unsetProviderEnv Keep the COXEDGE_* variables of the environment running the tests out of the provider block
+func unsetProviderEnv(t *testing.T) {
+	for _, env := range []string{"COXEDGE_KEY", "COXEDGE_API_BASE_URL", "COXEDGE_SERVICE_CODE", "COXEDGE_PROFILE", "COXEDGE_SHARED_CREDENTIALS_FILE", "COXEDGE_ORGANIZATION_ID", "COXEDGE_ENVIRONMENT_NAME"} {
+		t.Setenv(env, "")
+	}
+}
+
+func newTestProviderData(t *testing.T, raw map[string]interface{}) *schema.ResourceData {
+	unsetProviderEnv(t)
+	return schema.TestResourceDataRaw(t, Provider().Schema, raw)
+}
+
+func TestResolveCredentials(t *testing.T) {
+	path := writeTestCredentialsFile(t, testCredentialsFile)
+
+	testCases := []struct {
+		name   string
+		raw    map[string]interface{}
+		expect credentials
+	}{
+		{
+			name:   "default profile",
+			raw:    map[string]interface{}{"shared_credentials_file": path},
+			expect: credentials{Key: "default-key", OrganizationId: "default-org"},
+		},
+		{
+			name: "named profile",
+			raw:  map[string]interface{}{"shared_credentials_file": path, "profile": "uat"},
+			expect: credentials{
+				Key:             "uat-key",
+				APIBaseURL:      "https://cox.uat.cloudmc.io/api/v2",
+				ServiceCode:     "stackpath-cox-uat",
+				OrganizationId:  "uat-org",
+				EnvironmentName: "uat-env",
+			},
+		},
+		{
+			name: "arguments take precedence",
+			raw:  map[string]interface{}{"shared_credentials_file": path, "profile": "uat", "key": "argument-key", "organization_id": "argument-org"},
+			expect: credentials{
+				Key:             "argument-key",
+				APIBaseURL:      "https://cox.uat.cloudmc.io/api/v2",
+				ServiceCode:     "stackpath-cox-uat",
+				OrganizationId:  "argument-org",
+				EnvironmentName: "uat-env",
+			},
+		},
+		{
+			//The home directory is empty, so the default file is missing
+			name:   "missing default file without a profile",
+			raw:    map[string]interface{}{"key": "argument-key"},
+			expect: credentials{Key: "argument-key"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Setenv("HOME", t.TempDir())
+			resolved, err := resolveCredentials(newTestProviderData(t, tc.raw))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if resolved != tc.expect {
+				t.Errorf("expected %+v, got %+v", tc.expect, resolved)
+			}
+		})
+	}
+}
+
+func TestResolveCredentialsProfileFromEnvironment(t *testing.T) {
+	path := writeTestCredentialsFile(t, testCredentialsFile)
+	unsetProviderEnv(t)
+	t.Setenv("COXEDGE_SHARED_CREDENTIALS_FILE", path)
+	t.Setenv("COXEDGE_PROFILE", "uat")
+
+	resolved, err := resolveCredentials(schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resolved.Key != "uat-key" {
+		t.Errorf("expected the key of the uat profile, got %q", resolved.Key)
+	}
+}
+
+func TestResolveCredentialsErrors(t *testing.T) {
+	testCases := []struct {
+		name        string
+		content     string
+		raw         map[string]interface{}
+		expectError string
+	}{
+		{
+			name:        "unknown profile",
+			content:     testCredentialsFile,
+			raw:         map[string]interface{}{"profile": "production"},
+			expectError: `profile "production" not found`,
+		},
+		{
+			name:        "unknown key",
+			content:     "[default]\napi_key = abc\n",
+			expectError: `unknown key "api_key"`,
+		},
+		{
+			name:        "key outside a profile",
+			content:     "key = abc\n",
+			expectError: "key is not in a [profile] section",
+		},
+		{
+			name:        "malformed line",
+			content:     "[default]\nkey\n",
+			expectError: "expected key = value",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			raw := map[string]interface{}{"shared_credentials_file": writeTestCredentialsFile(t, tc.content)}
+			for k, v := range tc.raw {
+				raw[k] = v
+			}
+			_, err := resolveCredentials(newTestProviderData(t, raw))
+			if err == nil || !strings.Contains(err.Error(), tc.expectError) {
+				t.Errorf("expected an error containing %q, got %v", tc.expectError, err)
+			}
+		})
+	}
+
+	t.Run("missing file of a named profile", func(t *testing.T) {
+		raw := map[string]interface{}{"shared_credentials_file": filepath.Join(t.TempDir(), "missing"), "profile": "uat"}
+		if _, err := resolveCredentials(newTestProviderData(t, raw)); err == nil {
+			t.Error("expected an error")
+		}
+	})
+
+	t.Run("missing file that was set", func(t *testing.T) {
+		raw := map[string]interface{}{"shared_credentials_file": filepath.Join(t.TempDir(), "missing"), "key": "argument-key"}
+		if _, err := resolveCredentials(newTestProviderData(t, raw)); !os.IsNotExist(err) {
+			t.Errorf("expected a not exist error, got %v", err)
+		}
+	})
+
+	t.Run("missing default file of a named profile", func(t *testing.T) {
+		t.Setenv("HOME", t.TempDir())
+		raw := map[string]interface{}{"profile": "uat"}
+		if _, err := resolveCredentials(newTestProviderData(t, raw)); !os.IsNotExist(err) {
+			t.Errorf("expected a not exist error, got %v", err)
+		}
+	})
+}
+
+func TestValidateCredentials(t *testing.T) {
+	organizations := []apiclient.Organization{{Id: "org-1", Name: "Production"}}
+
+	testCases := []struct {
+		name           string
+		organizationId string
+		err            error
+		expectSummary  string
+	}{
+		{
+			name: "valid key",
+		},
+		{
+			name:           "valid key with access to the organization",
+			organizationId: "org-1",
+		},
+		{
+			name:          "invalid key",
+			err:           &apiclient.APIError{StatusCode: http.StatusUnauthorized, Message: "Invalid API key"},
+			expectSummary: "Invalid Cox Edge API key",
+		},
+		{
+			name:          "forbidden key",
+			err:           &apiclient.APIError{StatusCode: http.StatusForbidden},
+			expectSummary: "Invalid Cox Edge API key",
+		},
+		{
+			name:          "API error",
+			err:           &apiclient.APIError{StatusCode: http.StatusInternalServerError},
+			expectSummary: "Unable to validate the Cox Edge API key",
+		},
+		{
+			name:          "unreachable API",
+			err:           errors.New("dial tcp: connection refused"),
+			expectSummary: "Cox Edge API unreachable",
+		},
+		{
+			name:           "organization without access",
+			organizationId: "org-2",
+			expectSummary:  "No access to the Cox Edge organization",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			client := &mock.APIMock{
+				GetOrganizationsFunc: func(ctx context.Context) ([]apiclient.Organization, error) {
+					if tc.err != nil {
+						return nil, tc.err
+					}
+					return organizations, nil
+				},
+			}
+			diags := validateCredentials(context.Background(), client, credentials{Key: "key", OrganizationId: tc.organizationId})
+			if tc.expectSummary == "" {
+				if diags.HasError() {
+					t.Fatalf("unexpected diagnostics %v", diags)
+				}
+				return
+			}
+			if len(diags) != 1 || diags[0].Summary != tc.expectSummary {
+				t.Errorf("expected %q, got %v", tc.expectSummary, diags)
+			}
+		})
+	}
+}
@@ -0,0 +1,99 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+package coxedge
+
+import (
+	"context"
+	"coxedge/terraform-provider/coxedge/apiclient"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"strconv"
+	"time"
+)
+
+func dataSourceDeliveryDomains() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDeliveryDomainsRead,
+		Schema:      getListDataSourceSchema("delivery_domains", getDeliveryDomainDataSchema()),
+	}
+}
+
+func dataSourceDeliveryDomain() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDeliveryDomainRead,
+		Schema:      getLookupDataSourceSchema(getDeliveryDomainDataSchema(), "domain"),
+	}
+}
+
+//getDataSourceDeliveryDomains The delivery domains of the environment and organization of the data source
+func getDataSourceDeliveryDomains(ctx context.Context, d *schema.ResourceData, m interface{}) ([]apiclient.DeliveryDomain, error) {
+	coxEdgeClient := m.(apiclient.API)
+
+	environmentName, err := getWithProviderDefault(d, m, "environment_name")
+	if err != nil {
+		return nil, err
+	}
+	organizationId, err := getWithProviderDefault(d, m, "organization_id")
+	if err != nil {
+		return nil, err
+	}
+	return coxEdgeClient.GetDeliveryDomains(ctx, environmentName, organizationId)
+}
+
+func dataSourceDeliveryDomainsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	deliveryDomains, err := getDataSourceDeliveryDomains(ctx, d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("delivery_domains", flattenDeliveryDomainsData(deliveryDomains)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// always run
+	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+	return diags
+}
+
+func dataSourceDeliveryDomainRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	deliveryDomains, err := getDataSourceDeliveryDomains(ctx, d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	domain := d.Get("domain").(string)
+	deliveryDomain, err := lookupOne(deliveryDomains, fmt.Sprintf("domain %q", domain), func(deliveryDomain apiclient.DeliveryDomain) bool {
+		return deliveryDomain.Domain == domain
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(deliveryDomain.Id)
+	return setDataSourceAttributes(d, flattenDeliveryDomain(*deliveryDomain))
+}
+
+func flattenDeliveryDomainsData(deliveryDomains []apiclient.DeliveryDomain) []interface{} {
+	items := make([]interface{}, len(deliveryDomains), len(deliveryDomains))
+	for i, deliveryDomain := range deliveryDomains {
+		items[i] = flattenDeliveryDomain(deliveryDomain)
+	}
+	return items
+}
+
+func flattenDeliveryDomain(deliveryDomain apiclient.DeliveryDomain) map[string]interface{} {
+	item := make(map[string]interface{})
+	item["id"] = deliveryDomain.Id
+	item["stack_id"] = deliveryDomain.StackId
+	item["site_id"] = deliveryDomain.SiteId
+	item["domain"] = deliveryDomain.Domain
+	item["updated_at"] = deliveryDomain.UpdatedAt
+
+	return item
+}
@@ -0,0 +1,47 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+package coxedge
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"testing"
+)
+
+func TestAccDataSourceDeliveryDomains(t *testing.T) {
+	siteDomain := testAccName("tf-acc-site") + ".example.com"
+	domain := testAccName("tf-acc-cdn") + ".example.com"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceDeliveryDomainsConfig(siteDomain, domain),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.coxedge_delivery_domain.test", "id", "coxedge_delivery_domain.test", "id"),
+					resource.TestCheckResourceAttrPair("data.coxedge_delivery_domain.test", "site_id", "coxedge_site.test", "id"),
+					resource.TestCheckResourceAttrSet("data.coxedge_delivery_domains.test", "delivery_domains.0.domain"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceDeliveryDomainsConfig(siteDomain string, domain string) string {
+	return testAccDeliveryDomainConfig(siteDomain, domain) + `
+data "coxedge_delivery_domains" "test" {
+  organization_id  = coxedge_delivery_domain.test.organization_id
+  environment_name = coxedge_delivery_domain.test.environment_name
+  depends_on       = [coxedge_delivery_domain.test]
+}
+
+data "coxedge_delivery_domain" "test" {
+  organization_id  = coxedge_delivery_domain.test.organization_id
+  environment_name = coxedge_delivery_domain.test.environment_name
+  domain           = coxedge_delivery_domain.test.domain
+}
+`
+}
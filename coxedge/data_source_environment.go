@@ -22,14 +22,14 @@ func dataSourceEnvironment() *schema.Resource {
 }
 
 func dataSourceEnvironmentRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	coxEdgeClient := m.(apiclient.Client)
+	coxEdgeClient := m.(apiclient.API)
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	requestedId := d.Get("id").(string)
 	if requestedId != "" {
-		org, err := coxEdgeClient.GetEnvironment(requestedId)
+		org, err := coxEdgeClient.GetEnvironment(ctx, requestedId)
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -37,7 +37,7 @@ func dataSourceEnvironmentRead(ctx context.Context, d *schema.ResourceData, m in
 			return diag.FromErr(err)
 		}
 	} else {
-		orgs, err := coxEdgeClient.GetEnvironments()
+		orgs, err := coxEdgeClient.GetEnvironments(ctx)
 		if err != nil {
 			return diag.FromErr(err)
 		}
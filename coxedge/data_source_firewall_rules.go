@@ -0,0 +1,102 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+package coxedge
+
+import (
+	"context"
+	"coxedge/terraform-provider/coxedge/apiclient"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"strconv"
+	"time"
+)
+
+func dataSourceFirewallRules() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceFirewallRulesRead,
+		Schema:      getListDataSourceSchema("firewall_rules", getFirewallRuleDataSchema(), "site_id"),
+	}
+}
+
+func dataSourceFirewallRule() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceFirewallRuleRead,
+		Schema:      getLookupDataSourceSchema(getFirewallRuleDataSchema(), "site_id", "name"),
+	}
+}
+
+//getDataSourceFirewallRules The firewall rules of the site, environment and organization of the data source
+func getDataSourceFirewallRules(ctx context.Context, d *schema.ResourceData, m interface{}) ([]apiclient.FirewallRule, error) {
+	coxEdgeClient := m.(apiclient.API)
+
+	environmentName, err := getWithProviderDefault(d, m, "environment_name")
+	if err != nil {
+		return nil, err
+	}
+	organizationId, err := getWithProviderDefault(d, m, "organization_id")
+	if err != nil {
+		return nil, err
+	}
+	siteId := d.Get("site_id").(string)
+	return coxEdgeClient.GetFirewallRules(ctx, environmentName, siteId, organizationId)
+}
+
+func dataSourceFirewallRulesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	firewallRules, err := getDataSourceFirewallRules(ctx, d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("firewall_rules", flattenFirewallRulesData(firewallRules)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// always run
+	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+	return diags
+}
+
+func dataSourceFirewallRuleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	firewallRules, err := getDataSourceFirewallRules(ctx, d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Get("name").(string)
+	firewallRule, err := lookupOne(firewallRules, fmt.Sprintf("name %q", name), func(firewallRule apiclient.FirewallRule) bool {
+		return firewallRule.Name == name
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(firewallRule.Id)
+	return setDataSourceAttributes(d, flattenFirewallRule(*firewallRule))
+}
+
+func flattenFirewallRulesData(firewallRules []apiclient.FirewallRule) []interface{} {
+	items := make([]interface{}, len(firewallRules), len(firewallRules))
+	for i, firewallRule := range firewallRules {
+		items[i] = flattenFirewallRule(firewallRule)
+	}
+	return items
+}
+
+func flattenFirewallRule(firewallRule apiclient.FirewallRule) map[string]interface{} {
+	item := make(map[string]interface{})
+	item["id"] = firewallRule.Id
+	item["site_id"] = firewallRule.SiteId
+	item["name"] = firewallRule.Name
+	item["action"] = firewallRule.Action
+	item["enabled"] = firewallRule.Enabled
+	item["ip_start"] = firewallRule.IpStart
+	item["ip_end"] = firewallRule.IpEnd
+
+	return item
+}
@@ -0,0 +1,50 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+package coxedge
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"testing"
+)
+
+func TestAccDataSourceFirewallRules(t *testing.T) {
+	siteDomain := testAccName("tf-acc-site") + ".example.com"
+	ruleName := testAccName("tf-acc-rule")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceFirewallRulesConfig(siteDomain, ruleName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.coxedge_firewall_rule.test", "id", "coxedge_firewall_rule.test", "id"),
+					resource.TestCheckResourceAttr("data.coxedge_firewall_rule.test", "action", "BLOCK"),
+					resource.TestCheckResourceAttr("data.coxedge_firewall_rule.test", "ip_start", "192.0.2.6"),
+					resource.TestCheckResourceAttr("data.coxedge_firewall_rules.test", "firewall_rules.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceFirewallRulesConfig(siteDomain string, ruleName string) string {
+	return testAccFirewallRuleConfig(siteDomain, ruleName, "BLOCK") + `
+data "coxedge_firewall_rules" "test" {
+  organization_id  = coxedge_firewall_rule.test.organization_id
+  environment_name = coxedge_firewall_rule.test.environment_name
+  site_id          = coxedge_firewall_rule.test.site_id
+  depends_on       = [coxedge_firewall_rule.test]
+}
+
+data "coxedge_firewall_rule" "test" {
+  organization_id  = coxedge_firewall_rule.test.organization_id
+  environment_name = coxedge_firewall_rule.test.environment_name
+  site_id          = coxedge_firewall_rule.test.site_id
+  name             = coxedge_firewall_rule.test.name
+}
+`
+}
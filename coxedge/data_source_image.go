@@ -22,7 +22,7 @@ func dataSourceImage() *schema.Resource {
 }
 
 func dataSourceImageRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	coxEdgeClient := m.(apiclient.Client)
+	coxEdgeClient := m.(apiclient.API)
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -32,7 +32,7 @@ func dataSourceImageRead(ctx context.Context, d *schema.ResourceData, m interfac
 	requestedEnvironment := d.Get("environment").(string)
 
 	if requestedId != "" {
-		org, err := coxEdgeClient.GetImage(requestedEnvironment, requestedId)
+		org, err := coxEdgeClient.GetImage(ctx, requestedEnvironment, requestedId)
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -40,7 +40,7 @@ func dataSourceImageRead(ctx context.Context, d *schema.ResourceData, m interfac
 			return diag.FromErr(err)
 		}
 	} else {
-		orgs, err := coxEdgeClient.GetImages(requestedEnvironment)
+		orgs, err := coxEdgeClient.GetImages(ctx, requestedEnvironment)
 		if err != nil {
 			return diag.FromErr(err)
 		}
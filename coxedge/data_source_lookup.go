@@ -0,0 +1,36 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+package coxedge
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+//lookupOne The single object match accepts, an error naming the lookup when there is none or more than one
+func lookupOne[T any](objects []T, lookup string, match func(T) bool) (*T, error) {
+	var matches []T
+	for _, object := range objects {
+		if match(object) {
+			matches = append(matches, object)
+		}
+	}
+	if len(matches) != 1 {
+		return nil, fmt.Errorf("found %d objects with %s, expected exactly one", len(matches), lookup)
+	}
+	return &matches[0], nil
+}
+
+//setDataSourceAttributes Store a flattened object on a singular data source
+func setDataSourceAttributes(d *schema.ResourceData, item map[string]interface{}) diag.Diagnostics {
+	for key, value := range item {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return nil
+}
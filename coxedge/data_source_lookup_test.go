@@ -0,0 +1,44 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+package coxedge
+
+import (
+	"coxedge/terraform-provider/coxedge/apiclient"
+	"testing"
+)
+
+func TestLookupOne(t *testing.T) {
+	sites := []apiclient.Site{
+		{Id: "1", Domain: "a.example.com"},
+		{Id: "2", Domain: "b.example.com"},
+		{Id: "3", Domain: "b.example.com"},
+	}
+
+	testCases := []struct {
+		name        string
+		domain      string
+		expectId    string
+		expectError bool
+	}{
+		{name: "single match", domain: "a.example.com", expectId: "1"},
+		{name: "no match", domain: "c.example.com", expectError: true},
+		{name: "several matches", domain: "b.example.com", expectError: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			site, err := lookupOne(sites, "domain "+tc.domain, func(site apiclient.Site) bool {
+				return site.Domain == tc.domain
+			})
+			if (err != nil) != tc.expectError {
+				t.Fatalf("expected error %t, got %v", tc.expectError, err)
+			}
+			if site != nil && site.Id != tc.expectId {
+				t.Errorf("expected site %s, got %s", tc.expectId, site.Id)
+			}
+		})
+	}
+}
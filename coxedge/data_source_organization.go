@@ -22,14 +22,14 @@ func dataSourceOrganization() *schema.Resource {
 }
 
 func dataSourceOrganizationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	coxEdgeClient := m.(apiclient.Client)
+	coxEdgeClient := m.(apiclient.API)
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	requestedId := d.Get("id").(string)
 	if requestedId != "" {
-		org, err := coxEdgeClient.GetOrganization(requestedId)
+		org, err := coxEdgeClient.GetOrganization(ctx, requestedId)
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -37,7 +37,7 @@ func dataSourceOrganizationRead(ctx context.Context, d *schema.ResourceData, m i
 			return diag.FromErr(err)
 		}
 	} else {
-		orgs, err := coxEdgeClient.GetOrganizations()
+		orgs, err := coxEdgeClient.GetOrganizations(ctx)
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -17,14 +17,14 @@ func dataSourceOrganizationBillingInfo() *schema.Resource {
 }
 
 func dataSourceOrganizationBillingInfoRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	coxEdgeClient := m.(apiclient.Client)
+	coxEdgeClient := m.(apiclient.API)
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	requestedId := d.Get("id").(string)
 	if requestedId != "" {
-		org, err := coxEdgeClient.GetOrganizationBillingInfo(requestedId)
+		org, err := coxEdgeClient.GetOrganizationBillingInfo(ctx, requestedId)
 		if err != nil {
 			return diag.FromErr(err)
 		}
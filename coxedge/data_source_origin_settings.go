@@ -20,16 +20,22 @@ func dataSourceOriginSetting() *schema.Resource {
 }
 
 func dataSourceOriginSettingRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	coxEdgeClient := m.(apiclient.Client)
+	coxEdgeClient := m.(apiclient.API)
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	requestedId := d.Get("id").(string)
-	requestedEnv := d.Get("environment_name").(string)
-	organizationId := d.Get("organization_id").(string)
+	requestedEnv, err := getWithProviderDefault(d, m, "environment_name")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	organizationId, err := getWithProviderDefault(d, m, "organization_id")
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	if requestedId != "" && requestedEnv != "" {
-		org, err := coxEdgeClient.GetOriginSettings(requestedEnv, requestedId, organizationId)
+		org, err := coxEdgeClient.GetOriginSettings(ctx, requestedEnv, requestedId, organizationId)
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -17,12 +17,12 @@ func dataSourceRoles() *schema.Resource {
 }
 
 func dataSourceRolesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	coxEdgeClient := m.(apiclient.Client)
+	coxEdgeClient := m.(apiclient.API)
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
-	org, err := coxEdgeClient.GetRoles()
+	org, err := coxEdgeClient.GetRoles(ctx)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -0,0 +1,104 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+package coxedge
+
+import (
+	"context"
+	"coxedge/terraform-provider/coxedge/apiclient"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"strconv"
+	"time"
+)
+
+func dataSourceScripts() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceScriptsRead,
+		Schema:      getListDataSourceSchema("scripts", getScriptDataSchema(), "site_id"),
+	}
+}
+
+func dataSourceScript() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceScriptRead,
+		Schema:      getLookupDataSourceSchema(getScriptDataSchema(), "site_id", "name"),
+	}
+}
+
+//getDataSourceScripts The scripts of the site, environment and organization of the data source
+func getDataSourceScripts(ctx context.Context, d *schema.ResourceData, m interface{}) ([]apiclient.Script, error) {
+	coxEdgeClient := m.(apiclient.API)
+
+	environmentName, err := getWithProviderDefault(d, m, "environment_name")
+	if err != nil {
+		return nil, err
+	}
+	organizationId, err := getWithProviderDefault(d, m, "organization_id")
+	if err != nil {
+		return nil, err
+	}
+	siteId := d.Get("site_id").(string)
+	return coxEdgeClient.GetScripts(ctx, siteId, environmentName, organizationId)
+}
+
+func dataSourceScriptsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	scripts, err := getDataSourceScripts(ctx, d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("scripts", flattenScriptsData(scripts)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// always run
+	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+	return diags
+}
+
+func dataSourceScriptRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	scripts, err := getDataSourceScripts(ctx, d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Get("name").(string)
+	script, err := lookupOne(scripts, fmt.Sprintf("name %q", name), func(script apiclient.Script) bool {
+		return script.Name == name
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(script.Id)
+	return setDataSourceAttributes(d, flattenScript(*script))
+}
+
+func flattenScriptsData(scripts []apiclient.Script) []interface{} {
+	items := make([]interface{}, len(scripts), len(scripts))
+	for i, script := range scripts {
+		items[i] = flattenScript(script)
+	}
+	return items
+}
+
+func flattenScript(script apiclient.Script) map[string]interface{} {
+	item := make(map[string]interface{})
+	item["id"] = script.Id
+	item["stack_id"] = script.StackId
+	item["site_id"] = script.SiteId
+	item["name"] = script.Name
+	item["created_at"] = script.CreatedAt
+	item["updated_at"] = script.UpdatedAt
+	item["code"] = script.Code
+	item["version"] = script.Version
+	item["routes"] = script.Routes
+
+	return item
+}
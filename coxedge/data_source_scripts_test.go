@@ -0,0 +1,50 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+package coxedge
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"testing"
+)
+
+func TestAccDataSourceScripts(t *testing.T) {
+	siteDomain := testAccName("tf-acc-site") + ".example.com"
+	scriptName := testAccName("tf-acc-script")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceScriptsConfig(siteDomain, scriptName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.coxedge_script.test", "id", "coxedge_script.test", "id"),
+					resource.TestCheckResourceAttr("data.coxedge_script.test", "routes.0", "/api/*"),
+					resource.TestCheckResourceAttr("data.coxedge_scripts.test", "scripts.#", "1"),
+					resource.TestCheckResourceAttrPair("data.coxedge_scripts.test", "scripts.0.name", "coxedge_script.test", "name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceScriptsConfig(siteDomain string, scriptName string) string {
+	return testAccScriptConfig(siteDomain, scriptName, "/api/*") + `
+data "coxedge_scripts" "test" {
+  organization_id  = coxedge_script.test.organization_id
+  environment_name = coxedge_script.test.environment_name
+  site_id          = coxedge_script.test.site_id
+  depends_on       = [coxedge_script.test]
+}
+
+data "coxedge_script" "test" {
+  organization_id  = coxedge_script.test.organization_id
+  environment_name = coxedge_script.test.environment_name
+  site_id          = coxedge_script.test.site_id
+  name             = coxedge_script.test.name
+}
+`
+}
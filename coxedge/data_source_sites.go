@@ -0,0 +1,112 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+package coxedge
+
+import (
+	"context"
+	"coxedge/terraform-provider/coxedge/apiclient"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"strconv"
+	"time"
+)
+
+func dataSourceSites() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceSitesRead,
+		Schema:      getListDataSourceSchema("sites", getSiteDataSchema()),
+	}
+}
+
+func dataSourceSite() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceSiteRead,
+		Schema:      getLookupDataSourceSchema(getSiteDataSchema(), "domain"),
+	}
+}
+
+//getDataSourceSites The sites of the environment and organization of the data source
+func getDataSourceSites(ctx context.Context, d *schema.ResourceData, m interface{}) ([]apiclient.Site, error) {
+	coxEdgeClient := m.(apiclient.API)
+
+	environmentName, err := getWithProviderDefault(d, m, "environment_name")
+	if err != nil {
+		return nil, err
+	}
+	organizationId, err := getWithProviderDefault(d, m, "organization_id")
+	if err != nil {
+		return nil, err
+	}
+	return coxEdgeClient.GetSites(ctx, environmentName, organizationId)
+}
+
+func dataSourceSitesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	sites, err := getDataSourceSites(ctx, d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("sites", flattenSitesData(sites)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// always run
+	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+	return diags
+}
+
+func dataSourceSiteRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	sites, err := getDataSourceSites(ctx, d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	domain := d.Get("domain").(string)
+	site, err := lookupOne(sites, fmt.Sprintf("domain %q", domain), func(site apiclient.Site) bool {
+		return site.Domain == domain
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(site.Id)
+	return setDataSourceAttributes(d, flattenSite(*site))
+}
+
+func flattenSitesData(sites []apiclient.Site) []interface{} {
+	items := make([]interface{}, len(sites), len(sites))
+	for i, site := range sites {
+		items[i] = flattenSite(site)
+	}
+	return items
+}
+
+func flattenSite(site apiclient.Site) map[string]interface{} {
+	item := make(map[string]interface{})
+	item["id"] = site.Id
+	item["stack_id"] = site.StackId
+	item["domain"] = site.Domain
+	item["status"] = site.Status
+	item["created_at"] = site.CreatedAt
+	item["updated_at"] = site.UpdatedAt
+	item["services"] = site.Services
+	item["edge_address"] = site.EdgeAddress
+	item["anycast_ip"] = site.AnycastIp
+
+	deliveryDomains := make([]interface{}, len(site.DeliveryDomains), len(site.DeliveryDomains))
+	for i, deliveryDomain := range site.DeliveryDomains {
+		deliveryDomains[i] = map[string]interface{}{
+			"domain":       deliveryDomain.Domain,
+			"validated_at": deliveryDomain.ValidatedAt,
+		}
+	}
+	item["delivery_domains"] = deliveryDomains
+
+	return item
+}
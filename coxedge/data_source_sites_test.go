@@ -0,0 +1,53 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+package coxedge
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"regexp"
+	"testing"
+)
+
+func TestAccDataSourceSites(t *testing.T) {
+	siteDomain := testAccName("tf-acc-site") + ".example.com"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceSitesConfig(siteDomain, "coxedge_site.test.domain"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.coxedge_site.test", "id", "coxedge_site.test", "id"),
+					resource.TestCheckResourceAttrPair("data.coxedge_site.test", "stack_id", "coxedge_site.test", "stack_id"),
+					resource.TestCheckResourceAttr("data.coxedge_site.test", "services.#", "3"),
+					resource.TestCheckResourceAttrSet("data.coxedge_sites.test", "sites.0.id"),
+				),
+			},
+			{
+				Config:      testAccDataSourceSitesConfig(siteDomain, `"missing.example.com"`),
+				ExpectError: regexp.MustCompile(`found 0 objects with domain "missing.example.com"`),
+			},
+		},
+	})
+}
+
+func testAccDataSourceSitesConfig(siteDomain string, lookupDomain string) string {
+	return testAccSiteConfig(siteDomain, "") + fmt.Sprintf(`
+data "coxedge_sites" "test" {
+  organization_id  = coxedge_site.test.organization_id
+  environment_name = coxedge_site.test.environment_name
+  depends_on       = [coxedge_site.test]
+}
+
+data "coxedge_site" "test" {
+  organization_id  = coxedge_site.test.organization_id
+  environment_name = coxedge_site.test.environment_name
+  domain           = %s
+}
+`, lookupDomain)
+}
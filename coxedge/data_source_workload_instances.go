@@ -17,16 +17,22 @@ func dataWorkloadInstances() *schema.Resource {
 }
 
 func dataSourceWorkloadInstancesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	coxEdgeClient := m.(apiclient.Client)
+	coxEdgeClient := m.(apiclient.API)
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	requestedId := d.Get("id").(string)
-	environmentName := d.Get("environment_name").(string)
-	organizationId := d.Get("organization_id").(string)
+	environmentName, err := getWithProviderDefault(d, m, "environment_name")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	organizationId, err := getWithProviderDefault(d, m, "organization_id")
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
-	workloadInstances, err := coxEdgeClient.GetWorkloadInstances(environmentName, organizationId, requestedId)
+	workloadInstances, err := coxEdgeClient.GetWorkloadInstances(ctx, environmentName, organizationId, requestedId)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -0,0 +1,108 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+package coxedge
+
+import (
+	"context"
+	"coxedge/terraform-provider/coxedge/apiclient"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"strconv"
+	"time"
+)
+
+func dataSourceWorkloads() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceWorkloadsRead,
+		Schema:      getListDataSourceSchema("workloads", getWorkloadDataSchema()),
+	}
+}
+
+func dataSourceWorkload() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceWorkloadRead,
+		Schema:      getLookupDataSourceSchema(getWorkloadDataSchema(), "name"),
+	}
+}
+
+//getDataSourceWorkloads The workloads of the environment and organization of the data source
+func getDataSourceWorkloads(ctx context.Context, d *schema.ResourceData, m interface{}) ([]apiclient.Workload, error) {
+	coxEdgeClient := m.(apiclient.API)
+
+	environmentName, err := getWithProviderDefault(d, m, "environment_name")
+	if err != nil {
+		return nil, err
+	}
+	organizationId, err := getWithProviderDefault(d, m, "organization_id")
+	if err != nil {
+		return nil, err
+	}
+	return coxEdgeClient.GetWorkloads(ctx, environmentName, organizationId)
+}
+
+func dataSourceWorkloadsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	workloads, err := getDataSourceWorkloads(ctx, d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("workloads", flattenWorkloadsData(workloads)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// always run
+	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+	return diags
+}
+
+func dataSourceWorkloadRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	workloads, err := getDataSourceWorkloads(ctx, d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Get("name").(string)
+	workload, err := lookupOne(workloads, fmt.Sprintf("name %q", name), func(workload apiclient.Workload) bool {
+		return workload.Name == name
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(workload.Id)
+	return setDataSourceAttributes(d, flattenWorkload(*workload))
+}
+
+func flattenWorkloadsData(workloads []apiclient.Workload) []interface{} {
+	items := make([]interface{}, len(workloads), len(workloads))
+	for i, workload := range workloads {
+		items[i] = flattenWorkload(workload)
+	}
+	return items
+}
+
+func flattenWorkload(workload apiclient.Workload) map[string]interface{} {
+	item := make(map[string]interface{})
+	item["id"] = workload.Id
+	item["stack_id"] = workload.StackId
+	item["name"] = workload.Name
+	item["slug"] = workload.Slug
+	item["type"] = workload.Type
+	item["image"] = workload.Image
+	item["specs"] = workload.Specs
+	item["cpu"] = workload.CPU
+	item["memory"] = workload.Memory
+	item["network"] = workload.Network
+	item["anycast_ip_address"] = workload.AnycastIpAddress
+	item["status"] = workload.Status
+	item["version"] = workload.Version
+	item["created"] = workload.Created
+
+	return item
+}
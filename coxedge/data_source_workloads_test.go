@@ -0,0 +1,53 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+package coxedge
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"regexp"
+	"testing"
+)
+
+func TestAccDataSourceWorkloads(t *testing.T) {
+	workloadName := testAccName("tf-acc-wl")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceWorkloadsConfig(workloadName, "coxedge_workload.test.name"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.coxedge_workload.test", "id", "coxedge_workload.test", "id"),
+					resource.TestCheckResourceAttr("data.coxedge_workload.test", "type", "CONTAINER"),
+					resource.TestCheckResourceAttr("data.coxedge_workload.test", "image", "bitnami/nginx"),
+					resource.TestCheckResourceAttrSet("data.coxedge_workloads.test", "workloads.0.id"),
+				),
+			},
+			{
+				Config:      testAccDataSourceWorkloadsConfig(workloadName, `"missing"`),
+				ExpectError: regexp.MustCompile(`found 0 objects with name "missing"`),
+			},
+		},
+	})
+}
+
+func testAccDataSourceWorkloadsConfig(workloadName string, lookupName string) string {
+	return testAccWorkloadConfig(workloadName, 1) + fmt.Sprintf(`
+data "coxedge_workloads" "test" {
+  organization_id  = coxedge_workload.test.organization_id
+  environment_name = coxedge_workload.test.environment_name
+  depends_on       = [coxedge_workload.test]
+}
+
+data "coxedge_workload" "test" {
+  organization_id  = coxedge_workload.test.organization_id
+  environment_name = coxedge_workload.test.environment_name
+  name             = %s
+}
+`, lookupName)
+}
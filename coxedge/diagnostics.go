@@ -0,0 +1,45 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+package coxedge
+
+import (
+	"coxedge/terraform-provider/coxedge/apiclient"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"strings"
+)
+
+//taskDiagnostics Turn an error from awaiting a task into diagnostics. Failed tasks name the task and the resource
+//being changed and carry the details reported by the tasks API, any other error is returned as is.
+func taskDiagnostics(err error, operation string, resourceType string, resourceName string) diag.Diagnostics {
+	var taskErr *apiclient.TaskFailedError
+	if !errors.As(err, &taskErr) {
+		return diag.FromErr(err)
+	}
+
+	var detail []string
+	if taskErr.Reason != "" {
+		detail = append(detail, "Reason: "+taskErr.Reason)
+	}
+	if taskErr.ErrorCode != "" {
+		detail = append(detail, "Error code: "+taskErr.ErrorCode)
+	}
+	for _, message := range taskErr.Messages {
+		detail = append(detail, "- "+message)
+	}
+	if len(detail) == 0 {
+		detail = append(detail, "The tasks API did not report a reason for the failure.")
+	}
+
+	return diag.Diagnostics{
+		diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Task %s failed while trying to %s %s %q", taskErr.TaskId, operation, resourceType, resourceName),
+			Detail:   strings.Join(detail, "\n"),
+		},
+	}
+}
@@ -0,0 +1,74 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+package coxedge
+
+import (
+	"coxedge/terraform-provider/coxedge/apiclient"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"testing"
+)
+
+func TestTaskDiagnostics(t *testing.T) {
+	testCases := []struct {
+		name          string
+		err           error
+		expectSummary string
+		expectDetail  string
+	}{
+		{
+			name: "reason, error code and messages",
+			err: &apiclient.TaskFailedError{
+				TaskId:    "task-1",
+				Reason:    "out of capacity",
+				ErrorCode: "CAPACITY",
+				Messages:  []string{"no instances left in LAX", "retry later"},
+			},
+			expectSummary: `Task task-1 failed while trying to create coxedge_workload "web"`,
+			expectDetail:  "Reason: out of capacity\nError code: CAPACITY\n- no instances left in LAX\n- retry later",
+		},
+		{
+			name:          "reason only",
+			err:           &apiclient.TaskFailedError{TaskId: "task-2", Reason: "invalid image"},
+			expectSummary: `Task task-2 failed while trying to create coxedge_workload "web"`,
+			expectDetail:  "Reason: invalid image",
+		},
+		{
+			name:          "no reason",
+			err:           &apiclient.TaskFailedError{TaskId: "task-3"},
+			expectSummary: `Task task-3 failed while trying to create coxedge_workload "web"`,
+			expectDetail:  "The tasks API did not report a reason for the failure.",
+		},
+		{
+			name:          "wrapped task failure",
+			err:           fmt.Errorf("awaiting task: %w", &apiclient.TaskFailedError{TaskId: "task-4", ErrorCode: "E42"}),
+			expectSummary: `Task task-4 failed while trying to create coxedge_workload "web"`,
+			expectDetail:  "Error code: E42",
+		},
+		{
+			name:          "other error",
+			err:           errors.New("connection refused"),
+			expectSummary: "connection refused",
+			expectDetail:  "",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			diags := taskDiagnostics(tc.err, "create", "coxedge_workload", "web")
+			if len(diags) != 1 || diags[0].Severity != diag.Error {
+				t.Fatalf("expected a single error, got %v", diags)
+			}
+			if diags[0].Summary != tc.expectSummary {
+				t.Errorf("expected summary %q, got %q", tc.expectSummary, diags[0].Summary)
+			}
+			if diags[0].Detail != tc.expectDetail {
+				t.Errorf("expected detail %q, got %q", tc.expectDetail, diags[0].Detail)
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+package coxedge
+
+import (
+	"context"
+	"coxedge/terraform-provider/coxedge/apiclient"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+//awaitCreateTask Wait on the task of a create. The task ID is recorded in state first, so if the apply is interrupted
+//the next Read resumes waiting on it instead of leaving the created object orphaned. A cancelled apply is reported as a
+//warning, an error would taint the resource and have the next apply replace the object still being created. An
+//exceeded create timeout is an error, so the run stops before dependent resources use the task ID.
+//Callers stop on any returned diagnostics, the task is only resolved once nil is returned.
+func awaitCreateTask(ctx context.Context, d *schema.ResourceData, coxEdgeClient apiclient.TasksAPI, taskId string, resourceType string, resourceName string) diag.Diagnostics {
+	d.SetId(taskId)
+	d.Set("pending_task_id", taskId)
+
+	//Await
+	taskResult, err := coxEdgeClient.AwaitTaskResolveWithTimeout(ctx, taskId, d.Timeout(schema.TimeoutCreate))
+	var taskErr *apiclient.TaskFailedError
+	if errors.As(err, &taskErr) {
+		//Nothing was created, there is no task to resume
+		d.SetId("")
+		return taskDiagnostics(err, "create", resourceType, resourceName)
+	}
+	if errors.Is(err, context.Canceled) {
+		//Still running, keep the task in state for the next Read to resume
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("Stopped waiting for task %s to create %s %q", taskId, resourceType, resourceName),
+				Detail:   err.Error() + "\nThe task is kept in state and awaited again on the next refresh.",
+			},
+		}
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		//The task is kept in state, the next Read or Delete takes over the object it creates
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Timed out waiting for task %s to create %s %q", taskId, resourceType, resourceName),
+				Detail:   err.Error() + "\nThe task is kept in state and awaited again on the next refresh or destroy. Raise timeouts.create if the object takes longer to create.",
+			},
+		}
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	//Save the Id
+	adoptTaskResult(d, taskResult)
+	return nil
+}
+
+//resumePendingTask Wait on a create task left in state by an interrupted apply and adopt the object it created.
+//When the task failed nothing was created, so the resource is dropped from state and planned for creation again.
+func resumePendingTask(ctx context.Context, d *schema.ResourceData, coxEdgeClient apiclient.TasksAPI, resourceType string, resourceName string) diag.Diagnostics {
+	taskId := d.Get("pending_task_id").(string)
+	if taskId == "" {
+		return nil
+	}
+
+	tflog.Info(ctx, "Resuming interrupted create, awaiting task "+taskId)
+
+	//Await
+	taskResult, err := coxEdgeClient.AwaitTaskResolveWithTimeout(ctx, taskId, d.Timeout(schema.TimeoutCreate))
+	var taskErr *apiclient.TaskFailedError
+	if errors.As(err, &taskErr) {
+		d.SetId("")
+		diags := taskDiagnostics(err, "create", resourceType, resourceName)
+		diags[0].Severity = diag.Warning
+		return diags
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	adoptTaskResult(d, taskResult)
+	return nil
+}
+
+//adoptTaskResult Replace the pending task with the ID of the object it created
+func adoptTaskResult(d *schema.ResourceData, taskResult *apiclient.TaskStatus) {
+	d.SetId(taskResult.Data.Result.Id)
+	d.Set("pending_task_id", "")
+}
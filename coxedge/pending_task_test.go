@@ -0,0 +1,147 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+package coxedge
+
+import (
+	"context"
+	"coxedge/terraform-provider/coxedge/apiclient"
+	"coxedge/terraform-provider/coxedge/apiclient/mock"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"testing"
+	"time"
+)
+
+//newTaskMock Tasks API whose task-1 resolves to the given status, having created workload-1
+func newTaskMock(status string) *mock.APIMock {
+	return &mock.APIMock{
+		AwaitTaskResolveWithTimeoutFunc: func(ctx context.Context, taskId string, timeout time.Duration) (*apiclient.TaskStatus, error) {
+			task := &apiclient.TaskStatus{}
+			task.Data.TaskId = taskId
+			task.Data.TaskStatus = status
+			task.Data.Result.Id = "workload-1"
+			if status == apiclient.TaskFailed {
+				return task, &apiclient.TaskFailedError{TaskId: taskId, Reason: "out of capacity"}
+			}
+			return task, nil
+		},
+	}
+}
+
+func newPendingWorkloadData(t *testing.T) *schema.ResourceData {
+	d := schema.TestResourceDataRaw(t, getWorkloadSchema(), map[string]interface{}{"name": "web"})
+	d.SetId("task-1")
+	d.Set("pending_task_id", "task-1")
+	return d
+}
+
+func TestResumePendingTaskAdoptsCreatedObject(t *testing.T) {
+	client := newTaskMock(apiclient.TaskSuccess)
+
+	d := newPendingWorkloadData(t)
+	diags := resumePendingTask(context.Background(), d, client, "coxedge_workload", "web")
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	if d.Id() != "workload-1" || d.Get("pending_task_id").(string) != "" {
+		t.Errorf("expected the created workload to be adopted, got id %q pending %q", d.Id(), d.Get("pending_task_id"))
+	}
+}
+
+func TestResumePendingTaskDropsFailedCreate(t *testing.T) {
+	client := newTaskMock(apiclient.TaskFailed)
+
+	d := newPendingWorkloadData(t)
+	diags := resumePendingTask(context.Background(), d, client, "coxedge_workload", "web")
+	if d.Id() != "" {
+		t.Errorf("expected the resource to be dropped from state, got id %q", d.Id())
+	}
+	if len(diags) != 1 || diags[0].Severity != diag.Warning {
+		t.Errorf("expected a single warning, got %v", diags)
+	}
+}
+
+func TestResumePendingTaskSkipsSettledResources(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, getWorkloadSchema(), map[string]interface{}{"name": "web"})
+	d.SetId("workload-1")
+	//Nothing is mocked, the client must not be called
+	client := &mock.APIMock{}
+	diags := resumePendingTask(context.Background(), d, client, "coxedge_workload", "web")
+	if diags != nil || d.Id() != "workload-1" {
+		t.Errorf("unexpected change %v, id %q", diags, d.Id())
+	}
+	if len(client.AwaitTaskResolveWithTimeoutCalls()) != 0 {
+		t.Errorf("expected no task to be awaited")
+	}
+}
+
+func TestAwaitCreateTask(t *testing.T) {
+	testCases := []struct {
+		name                string
+		err                 error
+		expectId            string
+		expectPending       string
+		expectSeverity      diag.Severity
+		expectNoDiagnostics bool
+	}{
+		{name: "resolved", expectId: "workload-1", expectPending: "", expectNoDiagnostics: true},
+		{name: "failed", err: &apiclient.TaskFailedError{TaskId: "task-1", Reason: "out of capacity"}, expectId: "", expectPending: "task-1", expectSeverity: diag.Error},
+		{name: "canceled", err: context.Canceled, expectId: "task-1", expectPending: "task-1", expectSeverity: diag.Warning},
+		{name: "timed out", err: fmt.Errorf("timed out after 5m0s waiting for task task-1: %w", context.DeadlineExceeded), expectId: "task-1", expectPending: "task-1", expectSeverity: diag.Error},
+		{name: "other error", err: errors.New("connection refused"), expectId: "task-1", expectPending: "task-1", expectSeverity: diag.Error},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			client := &mock.APIMock{
+				AwaitTaskResolveWithTimeoutFunc: func(ctx context.Context, taskId string, timeout time.Duration) (*apiclient.TaskStatus, error) {
+					task := &apiclient.TaskStatus{}
+					task.Data.TaskId = taskId
+					task.Data.Result.Id = "workload-1"
+					return task, tc.err
+				},
+			}
+			d := schema.TestResourceDataRaw(t, getWorkloadSchema(), map[string]interface{}{"name": "web"})
+
+			diags := awaitCreateTask(context.Background(), d, client, "task-1", "coxedge_workload", "web")
+			if tc.expectNoDiagnostics {
+				if diags != nil {
+					t.Fatalf("unexpected diagnostics %v", diags)
+				}
+			} else if len(diags) != 1 || diags[0].Severity != tc.expectSeverity {
+				t.Fatalf("expected a single diagnostic of severity %v, got %v", tc.expectSeverity, diags)
+			}
+			if d.Id() != tc.expectId || d.Get("pending_task_id").(string) != tc.expectPending {
+				t.Errorf("expected id %q pending %q, got id %q pending %q", tc.expectId, tc.expectPending, d.Id(), d.Get("pending_task_id"))
+			}
+		})
+	}
+}
+
+func TestAwaitCreateTaskTimeoutIsResumed(t *testing.T) {
+	timedOut := &mock.APIMock{
+		AwaitTaskResolveWithTimeoutFunc: func(ctx context.Context, taskId string, timeout time.Duration) (*apiclient.TaskStatus, error) {
+			return nil, fmt.Errorf("timed out after %s waiting for task %s: %w", timeout, taskId, context.DeadlineExceeded)
+		},
+	}
+	d := schema.TestResourceDataRaw(t, getWorkloadSchema(), map[string]interface{}{"name": "web"})
+
+	diags := awaitCreateTask(context.Background(), d, timedOut, "task-1", "coxedge_workload", "web")
+	if !diags.HasError() {
+		t.Fatalf("expected an exceeded create timeout to fail the apply, got %v", diags)
+	}
+
+	//The next refresh takes over the object once the task resolves
+	diags = resumePendingTask(context.Background(), d, newTaskMock(apiclient.TaskSuccess), "coxedge_workload", "web")
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	if d.Id() != "workload-1" || d.Get("pending_task_id").(string) != "" {
+		t.Errorf("expected the created workload to be adopted, got id %q pending %q", d.Id(), d.Get("pending_task_id"))
+	}
+}
@@ -8,57 +8,293 @@ package coxedge
 import (
 	"context"
 	"coxedge/terraform-provider/coxedge/apiclient"
+	"fmt"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"time"
 )
 
+//Provider The provider of a development build
 func Provider() *schema.Provider {
-	return &schema.Provider{
+	return NewProvider("dev")
+}
+
+//NewProvider The provider, sending its version and the version of Terraform in the User-Agent of API requests
+func NewProvider(version string) *schema.Provider {
+	p := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"key": &schema.Schema{
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("COXEDGE_KEY", nil),
+				Sensitive:   true,
+				Description: "API key. Can also be set with the `COXEDGE_KEY` environment variable, or come from the `profile`.",
+			},
+			"api_base_url": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("COXEDGE_API_BASE_URL", nil),
+				Description: "Base URL of the Cox Edge API. Can also be set with the `COXEDGE_API_BASE_URL` environment variable, or come from the `profile`. Defaults to `" + apiclient.CoxEdgeAPIBase + "`.",
+			},
+			"service_code": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("COXEDGE_SERVICE_CODE", nil),
+				Description: "Service code used in service scoped API paths. Can also be set with the `COXEDGE_SERVICE_CODE` environment variable, or come from the `profile`. Defaults to `" + apiclient.CoxEdgeServiceCode + "`.",
+			},
+			"profile": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("COXEDGE_PROFILE", nil),
+				Description: "Profile of the shared credentials file to read the key, API base URL, service code, organization and environment from. Arguments set on the provider take precedence over the profile. Can also be set with the `COXEDGE_PROFILE` environment variable. Defaults to `" + defaultProfile + "`.",
+			},
+			"shared_credentials_file": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("COXEDGE_SHARED_CREDENTIALS_FILE", nil),
+				Description: "Path of the shared credentials file. Can also be set with the `COXEDGE_SHARED_CREDENTIALS_FILE` environment variable. Defaults to `" + defaultSharedCredentialsFile + "`, which may be missing. A file that is set must exist.",
+			},
+			"retry_max_attempts": &schema.Schema{
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     apiclient.DefaultRetryMaxAttempts,
+				Description: "Maximum number of attempts for idempotent API requests that fail with a transient error. Set to 1 to disable retries.",
+			},
+			"retry_min_backoff": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          apiclient.DefaultRetryMinBackoff.String(),
+				ValidateDiagFunc: validateDuration,
+				Description:      "Wait before the first retry, doubled on each following retry, e.g. `1s`.",
+			},
+			"retry_max_backoff": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          apiclient.DefaultRetryMaxBackoff.String(),
+				ValidateDiagFunc: validateDuration,
+				Description:      "Upper bound of the wait between retries, e.g. `30s`. A longer `Retry-After` from the API is still honored, up to `retry_max_retry_after`.",
+			},
+			"retry_max_retry_after": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          apiclient.DefaultRetryMaxRetryAfter.String(),
+				ValidateDiagFunc: validateDuration,
+				Description:      "Longest `Retry-After` from the API that is waited for, e.g. `2m`. A longer one is ignored and the regular backoff is used instead.",
+			},
+			"rate_limit_requests_per_second": &schema.Schema{
+				Type:        schema.TypeFloat,
+				Optional:    true,
+				Default:     apiclient.DefaultRateLimitRequestsPerSecond,
+				Description: "Sustained number of API requests per second, shared by every resource of a run. Set to 0 to disable the rate limit.",
+			},
+			"rate_limit_burst": &schema.Schema{
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     apiclient.DefaultRateLimitBurst,
+				Description: "Number of API requests that may be sent at once after a quiet period, above `rate_limit_requests_per_second`.",
+			},
+			"max_in_flight_requests": &schema.Schema{
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     apiclient.DefaultMaxInFlightRequests,
+				Description: "Number of API requests awaiting a response at any time, shared by every resource of a run. Defaults to 0, no cap beyond Terraform's `-parallelism`.",
+			},
+			"organization_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("COXEDGE_ORGANIZATION_ID", nil),
+				Description: "Organization used by resources and data sources that do not set their own `organization_id`. Can also be set with the `COXEDGE_ORGANIZATION_ID` environment variable, or come from the `profile`.",
+			},
+			"environment_name": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("COXEDGE_ENVIRONMENT_NAME", nil),
+				Description: "Environment used by resources and data sources that do not set their own `environment_name`. Can also be set with the `COXEDGE_ENVIRONMENT_NAME` environment variable, or come from the `profile`.",
+			},
+			"skip_credentials_validation": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("COXEDGE_SKIP_CREDENTIALS_VALIDATION", false),
+				Description: "Skip checking the key and its access to `organization_id` with the API when the provider is configured, e.g. for offline plans. Can also be set with the `COXEDGE_SKIP_CREDENTIALS_VALIDATION` environment variable.",
+			},
+			"enable_list_cache": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("COXEDGE_ENABLE_LIST_CACHE", false),
+				Description: "Read firewall rules, scripts and delivery domains from a single list call per environment, site and organization instead of one call each, which speeds up plans of large edge configurations. The lists are kept for the run and dropped when one of their objects is written. Can also be set with the `COXEDGE_ENABLE_LIST_CACHE` environment variable.",
+			},
+			"request_timeout": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          apiclient.DefaultRequestTimeout.String(),
+				ValidateDiagFunc: validateDuration,
+				Description:      "Limit on a single API request, reading the response included, e.g. `1m` for large WAF or CDN payloads on slow links. Set to `0s` to remove the limit.",
+			},
+			"proxy_url": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IsURLWithScheme([]string{"http", "https", "socks5"})),
+				Description:      "Proxy of every API request, e.g. `http://proxy.example.com:3128`. When unset the `HTTPS_PROXY`, `HTTP_PROXY` and `NO_PROXY` environment variables are used.",
+			},
+			"ca_bundle_file": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("COXEDGE_CA_BUNDLE_FILE", nil),
+				Description: "PEM file of certificate authorities to trust on top of the system ones, e.g. the private CA of a TLS inspecting proxy. Can also be set with the `COXEDGE_CA_BUNDLE_FILE` environment variable.",
+			},
+			"tls_min_version": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "1.2",
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"1.0", "1.1", "1.2", "1.3"}, false)),
+				Description:      "Lowest TLS version accepted from the API, one of `1.0`, `1.1`, `1.2` or `1.3`.",
+			},
+			"insecure_skip_verify": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Accept any certificate from the API. Only meant for labs, it exposes the key to anyone on the network path.",
+			},
+			"user_agent": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Product appended to the User-Agent of API requests, which already names the provider and Terraform versions, e.g. `my-pipeline/1.0`.",
 			},
 		},
 		DataSourcesMap: map[string]*schema.Resource{
 			"coxedge_organizations":              dataSourceOrganization(),
 			"coxedge_organizations_billing_info": dataSourceOrganizationBillingInfo(),
+			"coxedge_delivery_domain":            dataSourceDeliveryDomain(),
+			"coxedge_delivery_domains":           dataSourceDeliveryDomains(),
 			"coxedge_environments":               dataSourceEnvironment(),
+			"coxedge_firewall_rule":              dataSourceFirewallRule(),
+			"coxedge_firewall_rules":             dataSourceFirewallRules(),
 			"coxedge_images":                     dataSourceImage(),
 			"coxedge_origin_settings":            dataSourceOriginSetting(),
 			"coxedge_roles":                      dataSourceRoles(),
+			"coxedge_script":                     dataSourceScript(),
+			"coxedge_scripts":                    dataSourceScripts(),
+			"coxedge_site":                       dataSourceSite(),
+			"coxedge_sites":                      dataSourceSites(),
+			"coxedge_workload":                   dataSourceWorkload(),
 			"coxedge_workload_instances":         dataWorkloadInstances(),
+			"coxedge_workloads":                  dataSourceWorkloads(),
 		},
 		ResourcesMap: map[string]*schema.Resource{
 			"coxedge_cdn_purge":           resourceCDNPurgeResource(),
 			"coxedge_cdn_settings":        resourceCDNSettings(),
 			"coxedge_delivery_domain":     resourceDeliveryDomain(),
+			"coxedge_edge_logic":          resourceEdgeLogic(),
 			"coxedge_environment":         resourceEnvironment(),
+			"coxedge_environment_member":  resourceEnvironmentMember(),
 			"coxedge_firewall_rule":       resourceFirewallRule(),
 			"coxedge_network_policy_rule": resourceNetworkPolicyRule(),
 			"coxedge_origin_setting":      resourceOriginSettings(),
+			"coxedge_role":                resourceRole(),
 			"coxedge_script":              resourceScript(),
 			"coxedge_site":                resourceSite(),
 			"coxedge_user":                resourceUser(),
+			"coxedge_user_action":         resourceUserAction(),
 			"coxedge_waf_settings":        resourceWAFSettings(),
 			"coxedge_workload":            resourceWorkload(),
 		},
-		ConfigureContextFunc: providerConfigure,
 	}
+	p.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+		return providerConfigure(ctx, d, p.UserAgent(apiclient.DefaultUserAgent, version))
+	}
+	return p
 }
 
-func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
-	apiKey := d.Get("key").(string)
-
+func providerConfigure(ctx context.Context, d *schema.ResourceData, userAgent string) (interface{}, diag.Diagnostics) {
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
-	if apiKey != "" {
-		c := apiclient.NewClient(apiKey)
+	creds, err := resolveCredentials(d)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+
+	httpClient, err := apiclient.NewHTTPClient(getTransportConfig(d))
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
 
-		return c, diags
+	if creds.Key != "" {
+		c := apiclient.NewClient(creds.Key, creds.APIBaseURL, creds.ServiceCode)
+		c.HTTPClient = httpClient
+		c.UserAgent = userAgent
+		if extra := d.Get("user_agent").(string); extra != "" {
+			c.UserAgent += " " + extra
+		}
+		c.RetryPolicy = getRetryPolicy(d)
+		c.SetRateLimit(getRateLimit(d))
+		if d.Get("enable_list_cache").(bool) {
+			c.EnableListCache()
+		}
+
+		if !d.Get("skip_credentials_validation").(bool) {
+			if diags = validateCredentials(ctx, &c, creds); diags.HasError() {
+				return nil, diags
+			}
+		}
+
+		return &providerMeta{
+			API:             &c,
+			organizationId:  creds.OrganizationId,
+			environmentName: creds.EnvironmentName,
+		}, diags
 	}
 
-	return nil, diag.Errorf("No key set for key")
+	return nil, diag.Errorf("No key set, set key, COXEDGE_KEY or a profile of the shared credentials file")
+}
+
+func getRetryPolicy(d *schema.ResourceData) apiclient.RetryPolicy {
+	//Durations are validated in the schema, so parse errors cannot happen here
+	minBackoff, _ := time.ParseDuration(d.Get("retry_min_backoff").(string))
+	maxBackoff, _ := time.ParseDuration(d.Get("retry_max_backoff").(string))
+	maxRetryAfter, _ := time.ParseDuration(d.Get("retry_max_retry_after").(string))
+	return apiclient.RetryPolicy{
+		MaxAttempts:   d.Get("retry_max_attempts").(int),
+		MinBackoff:    minBackoff,
+		MaxBackoff:    maxBackoff,
+		MaxRetryAfter: maxRetryAfter,
+	}
+}
+
+func getTransportConfig(d *schema.ResourceData) apiclient.TransportConfig {
+	//Durations and versions are validated in the schema, so parse errors cannot happen here
+	timeout, _ := time.ParseDuration(d.Get("request_timeout").(string))
+	tlsMinVersion, _ := apiclient.ParseTLSVersion(d.Get("tls_min_version").(string))
+	return apiclient.TransportConfig{
+		Timeout:            timeout,
+		ProxyURL:           d.Get("proxy_url").(string),
+		CABundleFile:       d.Get("ca_bundle_file").(string),
+		TLSMinVersion:      tlsMinVersion,
+		InsecureSkipVerify: d.Get("insecure_skip_verify").(bool),
+	}
+}
+
+func getRateLimit(d *schema.ResourceData) apiclient.RateLimit {
+	return apiclient.RateLimit{
+		RequestsPerSecond: d.Get("rate_limit_requests_per_second").(float64),
+		Burst:             d.Get("rate_limit_burst").(int),
+		MaxInFlight:       d.Get("max_in_flight_requests").(int),
+	}
+}
+
+func validateDuration(i interface{}, path cty.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+	value := i.(string)
+	_, err := time.ParseDuration(value)
+	if err != nil {
+		diag := diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "wrong value",
+			Detail:   fmt.Sprintf("%q is not a valid duration such as \"5s\" or \"1m\"", value),
+		}
+		diags = append(diags, diag)
+	}
+	return diags
 }
@@ -0,0 +1,81 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+package coxedge
+
+import (
+	"context"
+	"coxedge/terraform-provider/coxedge/apiclient"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+//providerMeta The configured provider handed to resources. It is the API client, plus the organization_id and
+//environment_name set on the provider block that resources fall back to.
+type providerMeta struct {
+	apiclient.API
+	organizationId  string
+	environmentName string
+}
+
+//providerDefault The provider block value of organization_id or environment_name, empty when it is not set
+func providerDefault(m interface{}, key string) string {
+	meta, ok := m.(*providerMeta)
+	if !ok {
+		return ""
+	}
+	switch key {
+	case "organization_id":
+		return meta.organizationId
+	case "environment_name":
+		return meta.environmentName
+	}
+	return ""
+}
+
+//customizeDiffProviderDefaults Plan the provider block value of the keys the resource configuration leaves unset, so
+//the effective value is stored in state and a changed provider default shows up as a diff
+func customizeDiffProviderDefaults(keys ...string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+		config := d.GetRawConfig()
+		if config.IsNull() || !config.IsKnown() {
+			return nil
+		}
+		for _, key := range keys {
+			//Set on the resource, possibly to a value not known until apply
+			if !config.GetAttr(key).IsNull() {
+				continue
+			}
+			defaultValue := providerDefault(m, key)
+			if defaultValue == "" {
+				//Imported resources keep the value from their import ID
+				if d.Get(key).(string) == "" {
+					return fmt.Errorf("%s is not set, set it on the resource or on the provider", key)
+				}
+				continue
+			}
+			if d.Get(key).(string) != defaultValue {
+				if err := d.SetNew(key, defaultValue); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+}
+
+//getWithProviderDefault Read organization_id or environment_name of a data source, falling back to the provider block
+//value and storing the effective value
+func getWithProviderDefault(d *schema.ResourceData, m interface{}, key string) (string, error) {
+	if value := d.Get(key).(string); value != "" {
+		return value, nil
+	}
+	value := providerDefault(m, key)
+	if value == "" {
+		return "", fmt.Errorf("%s is not set, set it on the data source or on the provider", key)
+	}
+	d.Set(key, value)
+	return value, nil
+}
@@ -0,0 +1,86 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+package coxedge
+
+import (
+	"coxedge/terraform-provider/coxedge/apiclient/mock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"testing"
+)
+
+func TestProviderDefault(t *testing.T) {
+	meta := &providerMeta{API: &mock.APIMock{}, organizationId: "org-1", environmentName: "env-1"}
+
+	testCases := []struct {
+		name   string
+		meta   interface{}
+		key    string
+		expect string
+	}{
+		{name: "organization_id", meta: meta, key: "organization_id", expect: "org-1"},
+		{name: "environment_name", meta: meta, key: "environment_name", expect: "env-1"},
+		{name: "other key", meta: meta, key: "site_id", expect: ""},
+		{name: "not set on the provider", meta: &providerMeta{API: &mock.APIMock{}}, key: "organization_id", expect: ""},
+		{name: "plain client", meta: &mock.APIMock{}, key: "organization_id", expect: ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if value := providerDefault(tc.meta, tc.key); value != tc.expect {
+				t.Errorf("expected %q, got %q", tc.expect, value)
+			}
+		})
+	}
+}
+
+func TestGetWithProviderDefault(t *testing.T) {
+	testCases := []struct {
+		name        string
+		config      map[string]interface{}
+		meta        *providerMeta
+		expect      string
+		expectError bool
+	}{
+		{
+			name:   "set on the data source",
+			config: map[string]interface{}{"organization_id": "org-resource"},
+			meta:   &providerMeta{API: &mock.APIMock{}, organizationId: "org-provider"},
+			expect: "org-resource",
+		},
+		{
+			name:   "provider default",
+			config: map[string]interface{}{},
+			meta:   &providerMeta{API: &mock.APIMock{}, organizationId: "org-provider"},
+			expect: "org-provider",
+		},
+		{
+			name:        "set on neither",
+			config:      map[string]interface{}{},
+			meta:        &providerMeta{API: &mock.APIMock{}},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, getDataSourceScopeSchema(), tc.config)
+
+			value, err := getWithProviderDefault(d, tc.meta, "organization_id")
+			if tc.expectError {
+				if err == nil || err.Error() != "organization_id is not set, set it on the data source or on the provider" {
+					t.Errorf("expected a not set error, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if value != tc.expect || d.Get("organization_id").(string) != tc.expect {
+				t.Errorf("expected %q to be returned and stored, got %q stored %q", tc.expect, value, d.Get("organization_id"))
+			}
+		})
+	}
+}
@@ -0,0 +1,186 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+package coxedge
+
+import (
+	"context"
+	"coxedge/terraform-provider/coxedge/apiclient"
+	"coxedge/terraform-provider/coxedge/apiclient/fake"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"os"
+	"regexp"
+	"testing"
+)
+
+//Environment variables describing the account acceptance tests run against. When TF_ACC is set and COXEDGE_KEY is
+//not, the tests start the in-process fake API and fill these in from it.
+const (
+	testAccOrganizationIdEnv      = "COXEDGE_TEST_ORGANIZATION_ID"
+	testAccEnvironmentNameEnv     = "COXEDGE_TEST_ENVIRONMENT"
+	testAccServiceConnectionIdEnv = "COXEDGE_TEST_SERVICE_CONNECTION_ID"
+)
+
+var testAccProviderFactories = map[string]func() (*schema.Provider, error){
+	"coxedge": func() (*schema.Provider, error) {
+		return Provider(), nil
+	},
+}
+
+func TestMain(m *testing.M) {
+	if os.Getenv(resource.TestEnvVar) != "" && os.Getenv("COXEDGE_KEY") == "" {
+		server := fake.NewServer()
+		//Tasks resolve on the first poll
+		server.PendingPolls = 0
+		os.Setenv("COXEDGE_KEY", fake.APIKey)
+		os.Setenv("COXEDGE_API_BASE_URL", server.URL)
+		os.Setenv("COXEDGE_SERVICE_CODE", fake.ServiceCode)
+		os.Setenv(testAccOrganizationIdEnv, server.OrganizationId)
+		os.Setenv(testAccEnvironmentNameEnv, fake.EnvironmentName)
+		os.Setenv(testAccServiceConnectionIdEnv, server.ServiceConnectionId)
+		//The fake has nothing to settle
+		environmentSettleDelay = 0
+
+		code := m.Run()
+		server.Close()
+		os.Exit(code)
+	}
+	os.Exit(m.Run())
+}
+
+func TestProvider(t *testing.T) {
+	if err := Provider().InternalValidate(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+//A rejected key fails configure with a diagnostic naming the key, before anything is read
+func TestAccProviderInvalidKey(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "coxedge" {
+  key = "tf-acc-invalid-key"
+}
+
+data "coxedge_organizations" "test" {
+}
+`,
+				ExpectError: regexp.MustCompile("Invalid Cox Edge API key"),
+			},
+		},
+	})
+}
+
+func testAccPreCheck(t *testing.T) {
+	for _, name := range []string{"COXEDGE_KEY", testAccOrganizationIdEnv, testAccEnvironmentNameEnv, testAccServiceConnectionIdEnv} {
+		if os.Getenv(name) == "" {
+			t.Fatalf("%s must be set for acceptance tests", name)
+		}
+	}
+}
+
+//testAccClient Client for checking the API directly, configured the same way as the provider
+func testAccClient() *apiclient.Client {
+	client := apiclient.NewClient(os.Getenv("COXEDGE_KEY"), os.Getenv("COXEDGE_API_BASE_URL"), os.Getenv("COXEDGE_SERVICE_CODE"))
+	return &client
+}
+
+func testAccOrganizationId() string {
+	return os.Getenv(testAccOrganizationIdEnv)
+}
+
+func testAccEnvironmentName() string {
+	return os.Getenv(testAccEnvironmentNameEnv)
+}
+
+func testAccServiceConnectionId() string {
+	return os.Getenv(testAccServiceConnectionIdEnv)
+}
+
+//testAccRoleId ID of a role of the test organization. Looked up through the client instead of the coxedge_roles
+//data source, data sources in a config break the state verification of import steps.
+func testAccRoleId(t *testing.T) string {
+	if os.Getenv(resource.TestEnvVar) == "" {
+		//resource.Test skips the test
+		return ""
+	}
+	roles, err := testAccClient().GetRoles(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(roles) == 0 {
+		t.Fatal("the test organization has no roles")
+	}
+	return roles[0].Id
+}
+
+//testAccName Name for a test object that does not collide with objects of other runs on a shared account
+func testAccName(prefix string) string {
+	return fmt.Sprintf("%s-%s", prefix, resource.UniqueId()[len(resource.UniqueIdPrefix):])
+}
+
+//testAccCompositeImportId Import ID in the <id>:<environment>:<organization> form, optionally with the site ID
+//of site scoped resources before the environment
+func testAccCompositeImportId(resourceName string, withSite bool) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource %s not found in state", resourceName)
+		}
+		id := rs.Primary.ID
+		if withSite {
+			id += ":" + rs.Primary.Attributes["site_id"]
+		}
+		return fmt.Sprintf("%s:%s:%s", id, rs.Primary.Attributes["environment_name"], rs.Primary.Attributes["organization_id"]), nil
+	}
+}
+
+//testAccCheckDestroyed CheckDestroy that asserts every resource of the given type is gone from the API
+func testAccCheckDestroyed(resourceType string, exists func(ctx context.Context, client *apiclient.Client, rs *terraform.ResourceState) error) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccClient()
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != resourceType {
+				continue
+			}
+			err := exists(context.Background(), client, rs)
+			if err == nil {
+				return fmt.Errorf("%s %s still exists", resourceType, rs.Primary.ID)
+			}
+			if !apiclient.IsNotFound(err) {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+//testAccStoreResourceState Check that keeps the state of resourceName in rs, for steps changing the object outside of
+//Terraform afterwards
+func testAccStoreResourceState(resourceName string, rs **terraform.ResourceState) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		stored, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource %s not found in state", resourceName)
+		}
+		*rs = stored
+		return nil
+	}
+}
+
+//testAccProviderConfig Config shared by all acceptance tests
+func testAccProviderConfig() string {
+	return `
+provider "coxedge" {
+}
+`
+}
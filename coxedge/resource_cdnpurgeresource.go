@@ -20,13 +20,14 @@ func resourceCDNPurgeResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
-		Schema: getCDNPurgeResourceSchema(),
+		Schema:        getCDNPurgeResourceSchema(),
+		CustomizeDiff: customizeDiffProviderDefaults("organization_id", "environment_name"),
 	}
 }
 
 func resourceCDNPurgeResourceCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	//Get the API Client
-	coxEdgeClient := m.(apiclient.Client)
+	coxEdgeClient := m.(apiclient.API)
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -34,7 +35,7 @@ func resourceCDNPurgeResourceCreate(ctx context.Context, d *schema.ResourceData,
 	//Convert resource data to API Object
 	newCDNPurgeResource := convertResourceDataToCDNPurgeResourceCreateAPIObject(d)
 	//Call the API
-	createdCDNPurgeResource, err := coxEdgeClient.PurgeCDN(
+	createdCDNPurgeResource, err := coxEdgeClient.PurgeCDN(ctx,
 		d.Get("environment_name").(string),
 		d.Get("site_id").(string),
 		newCDNPurgeResource,
@@ -87,7 +88,8 @@ func convertResourceDataToCDNPurgeResourceCreateAPIObject(d *schema.ResourceData
 
 	items, hasItems := d.GetOk("items")
 	if hasItems {
-		for _, rawItem := range items.([]map[string]interface{}) {
+		for _, item := range items.([]interface{}) {
+			rawItem := item.(map[string]interface{})
 			newItem := struct {
 				URL             string   `json:"url,omitempty"`
 				Recursive       bool     `json:"recursive,omitempty"`
@@ -119,28 +121,30 @@ func convertResourceDataToCDNPurgeResourceCreateAPIObject(d *schema.ResourceData
 			}
 			headers, hasHeaders := rawItem["headers"]
 			if hasHeaders {
-				newItem.Headers = headers.([]string)
+				for _, header := range headers.([]interface{}) {
+					newItem.Headers = append(newItem.Headers, header.(string))
+				}
 			}
 
 			//Optional Nested Structure
 			purgeSelector, hasPurgeSelector := rawItem["purge_selector"]
-			if hasPurgeSelector {
-				selectors := purgeSelector.([]map[string]string)
-				name, hasName := selectors[0]["selector_name"]
+			if hasPurgeSelector && len(purgeSelector.([]interface{})) > 0 && purgeSelector.([]interface{})[0] != nil {
+				selector := purgeSelector.([]interface{})[0].(map[string]interface{})
+				name, hasName := selector["selector_name"]
 				if hasName {
-					newItem.PurgeSelector.SelectorName = name
+					newItem.PurgeSelector.SelectorName = name.(string)
 				}
-				selType, hasType := selectors[0]["selector_type"]
+				selType, hasType := selector["selector_type"]
 				if hasType {
-					newItem.PurgeSelector.SelectorType = selType
+					newItem.PurgeSelector.SelectorType = selType.(string)
 				}
-				selValue, hasValue := selectors[0]["selector_value"]
+				selValue, hasValue := selector["selector_value"]
 				if hasValue {
-					newItem.PurgeSelector.SelectorValue = selValue
+					newItem.PurgeSelector.SelectorValue = selValue.(string)
 				}
-				selValDel, hasDel := selectors[0]["selector_value_delimiter"]
+				selValDel, hasDel := selector["selector_value_delimiter"]
 				if hasDel {
-					newItem.PurgeSelector.SelectorValueDelimiter = selValDel
+					newItem.PurgeSelector.SelectorValueDelimiter = selValDel.(string)
 				}
 			}
 
@@ -0,0 +1,70 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+package coxedge
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"testing"
+)
+
+//Purges are one-shot requests, so there is no update in place, no import and nothing to check after destroy.
+//Changing the purge replaces it, which sends a new purge.
+func TestAccCDNPurge(t *testing.T) {
+	siteDomain := testAccName("tf-acc-site") + ".example.com"
+	resourceName := "coxedge_cdn_purge.test"
+	var firstPurgeId string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckDestroyed("coxedge_site", testAccSiteExists),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCDNPurgeConfig(siteDomain, "/index.html"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "purge_type", "URL"),
+					resource.TestCheckResourceAttr(resourceName, "items.0.url", "https://"+siteDomain+"/index.html"),
+					func(s *terraform.State) error {
+						firstPurgeId = s.RootModule().Resources[resourceName].Primary.ID
+						return nil
+					},
+				),
+			},
+			{
+				Config: testAccCDNPurgeConfig(siteDomain, "/images/"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "items.0.url", "https://"+siteDomain+"/images/"),
+					func(s *terraform.State) error {
+						if s.RootModule().Resources[resourceName].Primary.ID == firstPurgeId {
+							return fmt.Errorf("expected changing the purge to send a new one")
+						}
+						return nil
+					},
+				),
+			},
+			{
+				Config:   testAccCDNPurgeConfig(siteDomain, "/images/"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccCDNPurgeConfig(siteDomain string, path string) string {
+	return testAccProviderConfig() + testAccSiteResource(siteDomain, "") + fmt.Sprintf(`
+resource "coxedge_cdn_purge" "test" {
+  organization_id  = coxedge_site.test.organization_id
+  environment_name = coxedge_site.test.environment_name
+  site_id          = coxedge_site.test.id
+  items {
+    url       = "https://${coxedge_site.test.domain}%s"
+    recursive = true
+  }
+}
+`, path)
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"strconv"
 	"strings"
+	"time"
 )
 
 func resourceCDNSettings() *schema.Resource {
@@ -24,25 +25,25 @@ func resourceCDNSettings() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
-		Schema: getCDNSettingsSchema(),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema:        getCDNSettingsSchema(),
+		CustomizeDiff: customizeDiffProviderDefaults("organization_id", "environment_name"),
 	}
 }
 
 func resourceCDNSettingsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	// Warning or errors can be collected in a slice type
-	var diags diag.Diagnostics
-
 	//Convert to struct
 	updatedCDNSettings := convertResourceDataToCDNSettingsCreateAPIObject(d)
 	d.SetId(updatedCDNSettings.Id)
-	resourceCDNSettingsUpdate(ctx, d, m)
-
-	return diags
+	return resourceCDNSettingsUpdate(ctx, d, m)
 }
 
 func resourceCDNSettingsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	//Get the API Client
-	coxEdgeClient := m.(apiclient.Client)
+	coxEdgeClient := m.(apiclient.API)
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 	//check the id comes with id & environment_name, then split the value -> in case of importing the resource
@@ -58,7 +59,12 @@ func resourceCDNSettingsRead(ctx context.Context, d *schema.ResourceData, m inte
 	resourceId := d.Id()
 	organizationId := d.Get("organization_id").(string)
 	//Get the resource
-	cdnSettings, err := coxEdgeClient.GetCDNSettings(d.Get("environment_name").(string), resourceId, organizationId)
+	cdnSettings, err := coxEdgeClient.GetCDNSettings(ctx, d.Get("environment_name").(string), resourceId, organizationId)
+	if apiclient.IsNotFound(err) {
+		//Removed outside of Terraform, drop it from state so it is recreated
+		d.SetId("")
+		return diags
+	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -70,21 +76,26 @@ func resourceCDNSettingsRead(ctx context.Context, d *schema.ResourceData, m inte
 
 func resourceCDNSettingsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	//Get the API Client
-	coxEdgeClient := m.(apiclient.Client)
+	coxEdgeClient := m.(apiclient.API)
 
 	//Convert resource data to API object
 	updatedCDNSettings := convertResourceDataToCDNSettingsCreateAPIObject(d)
 	organizationId := d.Get("organization_id").(string)
 	//Call the API
-	taskResp, err := coxEdgeClient.UpdateCDNSettings(updatedCDNSettings.Id, updatedCDNSettings, organizationId)
+	taskResp, err := coxEdgeClient.UpdateCDNSettings(ctx, updatedCDNSettings.Id, updatedCDNSettings, organizationId)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	//Await
-	_, err = coxEdgeClient.AwaitTaskResolveWithDefaults(ctx, taskResp.TaskId)
+	//Settings are "created" through an update, so wait as long as the running operation allows
+	operation, timeout := "update", d.Timeout(schema.TimeoutUpdate)
+	if d.IsNewResource() {
+		operation, timeout = "create", d.Timeout(schema.TimeoutCreate)
+	}
+	_, err = coxEdgeClient.AwaitTaskResolveWithTimeout(ctx, taskResp.TaskId, timeout)
 	if err != nil {
-		return diag.FromErr(err)
+		return taskDiagnostics(err, operation, "coxedge_cdn_settings", d.Get("site_id").(string))
 	}
 
 	return resourceCDNSettingsRead(ctx, d, m)
@@ -246,7 +257,8 @@ func convertResourceDataToCDNSettingsCreateAPIObject(d *schema.ResourceData) api
 
 func convertCDNSettingsAPIObjectToResourceData(d *schema.ResourceData, cdnSettings *apiclient.CDNSettings) {
 	//Store the data
-	d.Set("site_id", cdnSettings.Id)
+	//The settings are keyed by their site, the ID is the site id
+	d.Set("site_id", d.Id())
 	d.Set("cache_expire_policy", cdnSettings.CacheExpirePolicy)
 	d.Set("cache_ttl", cdnSettings.CacheTtl)
 	d.Set("query_string_control", cdnSettings.QueryStringControl)
@@ -255,7 +267,9 @@ func convertCDNSettingsAPIObjectToResourceData(d *schema.ResourceData, cdnSettin
 		d.Set("dynamic_caching_by_header_enabled", strconv.FormatBool(*cdnSettings.DynamicCachingByHeaderEnabled))
 	}
 	d.Set("custom_cached_headers", cdnSettings.CustomCacheHeaders)
-	d.Set("gzip_compression_enabled", cdnSettings.GzipCompressionEnabled)
+	if cdnSettings.GzipCompressionEnabled != nil {
+		d.Set("gzip_compression_enabled", strconv.FormatBool(*cdnSettings.GzipCompressionEnabled))
+	}
 	d.Set("gzip_compression_level", cdnSettings.GzipCompressionLevel)
 	if cdnSettings.ContentPersistenceEnabled != nil {
 		d.Set("content_persistence_enabled", strconv.FormatBool(*cdnSettings.ContentPersistenceEnabled))
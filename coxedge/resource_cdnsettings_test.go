@@ -0,0 +1,92 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+package coxedge
+
+import (
+	"context"
+	"coxedge/terraform-provider/coxedge/apiclient"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"testing"
+)
+
+func TestAccCDNSettings(t *testing.T) {
+	siteDomain := testAccName("tf-acc-site") + ".example.com"
+	resourceName := "coxedge_cdn_settings.test"
+	var rs *terraform.ResourceState
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		//Settings live as long as their site, destroying them only drops them from state
+		CheckDestroy: testAccCheckDestroyed("coxedge_site", testAccSiteExists),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCDNSettingsConfig(siteDomain, 60, "true"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "site_id", "coxedge_site.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "cache_expire_policy", "SPECIFY_CDN_TTL"),
+					resource.TestCheckResourceAttr(resourceName, "cache_ttl", "60"),
+					resource.TestCheckResourceAttr(resourceName, "gzip_compression_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "custom_cached_query_strings.0", "customQuery"),
+				),
+			},
+			{
+				Config: testAccCDNSettingsConfig(siteDomain, 300, "false"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "cache_ttl", "300"),
+					resource.TestCheckResourceAttr(resourceName, "gzip_compression_enabled", "false"),
+					testAccStoreResourceState(resourceName, &rs),
+				),
+			},
+			{
+				Config:   testAccCDNSettingsConfig(siteDomain, 300, "false"),
+				PlanOnly: true,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccCompositeImportId(resourceName, false),
+				ImportStateVerify: true,
+			},
+			{
+				//Settings changed outside of Terraform are planned to be changed back
+				PreConfig: func() {
+					testAccChangeCDNSettingsCacheTtl(t, rs)
+				},
+				Config:             testAccCDNSettingsConfig(siteDomain, 300, "false"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccChangeCDNSettingsCacheTtl(t *testing.T, rs *terraform.ResourceState) {
+	settings := apiclient.CDNSettings{EnvironmentName: rs.Primary.Attributes["environment_name"], CacheTtl: 60}
+	_, err := testAccClient().UpdateCDNSettings(context.Background(), rs.Primary.ID, settings, rs.Primary.Attributes["organization_id"])
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testAccCDNSettingsConfig(siteDomain string, cacheTtl int, gzipEnabled string) string {
+	return testAccProviderConfig() + testAccSiteResource(siteDomain, "") + fmt.Sprintf(`
+resource "coxedge_cdn_settings" "test" {
+  organization_id             = coxedge_site.test.organization_id
+  environment_name            = coxedge_site.test.environment_name
+  site_id                     = coxedge_site.test.id
+  cache_expire_policy         = "SPECIFY_CDN_TTL"
+  cache_ttl                   = %d
+  query_string_control        = "CUSTOM"
+  custom_cached_query_strings = ["customQuery"]
+  gzip_compression_enabled    = %q
+  gzip_compression_level      = 2
+  browser_cache_ttl           = 60
+}
+`, cacheTtl, gzipEnabled)
+}
@@ -10,6 +10,8 @@ import (
 	"coxedge/terraform-provider/coxedge/apiclient"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"strings"
+	"time"
 )
 
 func resourceDeliveryDomain() *schema.Resource {
@@ -21,13 +23,19 @@ func resourceDeliveryDomain() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
-		Schema: getDeliveryDomainSchema(),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema:        getDeliveryDomainSchema(),
+		CustomizeDiff: customizeDiffProviderDefaults("organization_id", "environment_name"),
 	}
 }
 
 func resourceDeliveryDomainCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	//Get the API Client
-	coxEdgeClient := m.(apiclient.Client)
+	coxEdgeClient := m.(apiclient.API)
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -38,36 +46,54 @@ func resourceDeliveryDomainCreate(ctx context.Context, d *schema.ResourceData, m
 	resourceId := d.Get("site_id").(string)
 	organizationId := d.Get("organization_id").(string)
 	//Call the API
-	createdDeliveryDomain, err := coxEdgeClient.CreateDeliveryDomain(resourceId, newDeliveryDomain, organizationId)
+	createdDeliveryDomain, err := coxEdgeClient.CreateDeliveryDomain(ctx, resourceId, newDeliveryDomain, organizationId)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	//Await
-	taskResult, err := coxEdgeClient.AwaitTaskResolveWithDefaults(ctx, createdDeliveryDomain.TaskId)
-	if err != nil {
-		return diag.FromErr(err)
+	//Await, recording the task in state so an interrupted apply can resume it
+	if diags = awaitCreateTask(ctx, d, coxEdgeClient, createdDeliveryDomain.TaskId, "coxedge_delivery_domain", d.Get("domain").(string)); diags != nil {
+		return diags
 	}
 
-	//Save the Id
-	d.SetId(taskResult.Data.Result.Id)
-
-	return diags
+	return resourceDeliveryDomainRead(ctx, d, m)
 }
 
 func resourceDeliveryDomainRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	//Get the API Client
-	coxEdgeClient := m.(apiclient.Client)
+	coxEdgeClient := m.(apiclient.API)
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
+	//check the id comes with id, environment_name & organization_id, then split the value -> in case of importing the resource
+	//format is <delivery_domain_id>:<environment_name>:<organization_id>
+	if strings.Contains(d.Id(), ":") {
+		keys := strings.Split(d.Id(), ":")
+		if len(keys) != 3 {
+			return diag.Errorf("unexpected import ID %q, expected <delivery_domain_id>:<environment_name>:<organization_id>", d.Id())
+		}
+		d.SetId(keys[0])
+		d.Set("environment_name", keys[1])
+		d.Set("organization_id", keys[2])
+	}
+
+	//Resume a create that was interrupted while awaiting its task
+	if diags = resumePendingTask(ctx, d, coxEdgeClient, "coxedge_delivery_domain", d.Get("domain").(string)); diags.HasError() || d.Id() == "" {
+		return diags
+	}
+
 	//Get the resource Id
 	resourceId := d.Id()
 	organizationId := d.Get("organization_id").(string)
 
 	//Get the resource
-	deliveryDomain, err := coxEdgeClient.GetDeliveryDomain(d.Get("environment_name").(string), resourceId, organizationId)
+	deliveryDomain, err := coxEdgeClient.GetDeliveryDomain(ctx, d.Get("environment_name").(string), resourceId, organizationId)
+	if apiclient.IsNotFound(err) {
+		//Removed outside of Terraform, drop it from state so it is recreated
+		d.SetId("")
+		return diags
+	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -86,14 +112,19 @@ func resourceDeliveryDomainDelete(ctx context.Context, d *schema.ResourceData, m
 	var diags diag.Diagnostics
 
 	//Get the API Client
-	coxEdgeClient := m.(apiclient.Client)
+	coxEdgeClient := m.(apiclient.API)
+
+	//Resolve an interrupted create first, so the created object is deleted rather than the task
+	if diags = resumePendingTask(ctx, d, coxEdgeClient, "coxedge_delivery_domain", d.Get("domain").(string)); diags.HasError() || d.Id() == "" {
+		return diags
+	}
 
 	//Get the resource Id
 	resourceId := d.Id()
 	organizationId := d.Get("organization_id").(string)
 
 	//Delete the DeliveryDomain
-	err := coxEdgeClient.DeleteDeliveryDomain(d.Get("environment_name").(string), resourceId, organizationId)
+	err := coxEdgeClient.DeleteDeliveryDomain(ctx, d.Get("environment_name").(string), resourceId, organizationId)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -121,4 +152,8 @@ func convertDeliveryDomainAPIObjectToResourceData(d *schema.ResourceData, delive
 	d.Set("stack_id", deliveryDomain.StackId)
 	d.Set("domain", deliveryDomain.Domain)
 	d.Set("updated_at", deliveryDomain.UpdatedAt)
+	//Keep the configured site when the API leaves it out
+	if deliveryDomain.SiteId != "" {
+		d.Set("site_id", deliveryDomain.SiteId)
+	}
 }
@@ -0,0 +1,88 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+package coxedge
+
+import (
+	"context"
+	"coxedge/terraform-provider/coxedge/apiclient"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"regexp"
+	"testing"
+)
+
+func TestAccDeliveryDomain(t *testing.T) {
+	siteDomain := testAccName("tf-acc-site") + ".example.com"
+	domain := "cdn." + siteDomain
+	resourceName := "coxedge_delivery_domain.test"
+	var rs *terraform.ResourceState
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckDestroyed("coxedge_delivery_domain", testAccDeliveryDomainExists),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeliveryDomainConfig(siteDomain, domain),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "domain", domain),
+					resource.TestCheckResourceAttrPair(resourceName, "site_id", "coxedge_site.test", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "stack_id"),
+					testAccStoreResourceState(resourceName, &rs),
+				),
+			},
+			{
+				Config:   testAccDeliveryDomainConfig(siteDomain, domain),
+				PlanOnly: true,
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateIdFunc:       testAccCompositeImportId(resourceName, false),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"pending_task_id"},
+			},
+			{
+				//Delivery domains cannot be changed in place
+				Config:      testAccDeliveryDomainConfig(siteDomain, "static."+siteDomain),
+				ExpectError: regexp.MustCompile("no option to update delivery domain"),
+			},
+			{
+				//A delivery domain deleted outside of Terraform is planned to be created again
+				PreConfig: func() {
+					testAccDeleteDeliveryDomain(t, rs)
+				},
+				Config:             testAccDeliveryDomainConfig(siteDomain, domain),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccDeliveryDomainExists(ctx context.Context, client *apiclient.Client, rs *terraform.ResourceState) error {
+	_, err := client.GetDeliveryDomain(ctx, rs.Primary.Attributes["environment_name"], rs.Primary.ID, rs.Primary.Attributes["organization_id"])
+	return err
+}
+
+func testAccDeleteDeliveryDomain(t *testing.T, rs *terraform.ResourceState) {
+	err := testAccClient().DeleteDeliveryDomain(context.Background(), rs.Primary.Attributes["environment_name"], rs.Primary.ID, rs.Primary.Attributes["organization_id"])
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testAccDeliveryDomainConfig(siteDomain string, domain string) string {
+	return testAccProviderConfig() + testAccSiteResource(siteDomain, "") + fmt.Sprintf(`
+resource "coxedge_delivery_domain" "test" {
+  organization_id  = coxedge_site.test.organization_id
+  environment_name = coxedge_site.test.environment_name
+  site_id          = coxedge_site.test.id
+  domain           = %q
+}
+`, domain)
+}
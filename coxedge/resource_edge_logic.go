@@ -0,0 +1,162 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+package coxedge
+
+import (
+	"context"
+	"coxedge/terraform-provider/coxedge/apiclient"
+	"coxedge/terraform-provider/coxedge/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"strconv"
+	"strings"
+	"time"
+)
+
+func resourceEdgeLogic() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceEdgeLogicCreate,
+		ReadContext:   resourceEdgeLogicRead,
+		UpdateContext: resourceEdgeLogicUpdate,
+		DeleteContext: resourceEdgeLogicDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema:        getEdgeLogicSchema(),
+		CustomizeDiff: customizeDiffProviderDefaults("organization_id", "environment_name"),
+	}
+}
+
+func resourceEdgeLogicCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	//Edge logic exists as long as its site, so it is keyed by the site
+	d.SetId(d.Get("site_id").(string))
+
+	//Run Update since you do not "create" these
+	return resourceEdgeLogicUpdate(ctx, d, m)
+}
+
+func resourceEdgeLogicRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	//Get the API Client
+	coxEdgeClient := m.(apiclient.API)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	//check the id comes with id, environment_name & organization_id, then split the value -> in case of importing the resource
+	//format is <site_id>:<environment_name>:<organization_id>
+	if strings.Contains(d.Id(), ":") {
+		keys := strings.Split(d.Id(), ":")
+		if len(keys) != 3 {
+			return diag.Errorf("unexpected import ID %q, expected <site_id>:<environment_name>:<organization_id>", d.Id())
+		}
+		d.SetId(keys[0])
+		d.Set("environment_name", keys[1])
+		d.Set("organization_id", keys[2])
+	}
+
+	//Get the resource Id
+	resourceId := d.Id()
+	organizationId := d.Get("organization_id").(string)
+	//Get the resource
+	edgeLogic, err := coxEdgeClient.GetEdgeLogic(ctx, d.Get("environment_name").(string), resourceId, organizationId)
+	if apiclient.IsNotFound(err) {
+		//Removed with its site outside of Terraform, drop it from state so it is recreated
+		d.SetId("")
+		return diags
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	convertEdgeLogicAPIObjectToResourceData(d, edgeLogic)
+
+	return diags
+}
+
+func resourceEdgeLogicUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	//Get the API Client
+	coxEdgeClient := m.(apiclient.API)
+
+	//Convert resource data to API object
+	updatedEdgeLogic := convertResourceDataToEdgeLogicAPIObject(d)
+	organizationId := d.Get("organization_id").(string)
+	//Call the API
+	taskResp, err := coxEdgeClient.UpdateEdgeLogic(ctx, updatedEdgeLogic.Id, updatedEdgeLogic, organizationId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	//Await
+	//Edge logic is "created" through an update, so wait as long as the running operation allows
+	operation, timeout := "update", d.Timeout(schema.TimeoutUpdate)
+	if d.IsNewResource() {
+		operation, timeout = "create", d.Timeout(schema.TimeoutCreate)
+	}
+	_, err = coxEdgeClient.AwaitTaskResolveWithTimeout(ctx, taskResp.TaskId, timeout)
+	if err != nil {
+		return taskDiagnostics(err, operation, "coxedge_edge_logic", d.Get("site_id").(string))
+	}
+
+	return resourceEdgeLogicRead(ctx, d, m)
+}
+
+func resourceEdgeLogicDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	//Edge logic lives as long as its site, so destroying it only removes it from state
+	d.SetId("")
+
+	return diags
+}
+
+func convertResourceDataToEdgeLogicAPIObject(d *schema.ResourceData) apiclient.EdgeLogic {
+	//Create update edgeLogic struct
+	updatedEdgeLogic := apiclient.EdgeLogic{
+		EnvironmentName: d.Get("environment_name").(string),
+		Id:              d.Get("site_id").(string),
+		RobotTxtFile:    d.Get("robot_txt_file").(string),
+	}
+
+	//Booleans left out of the configuration are not sent, so the portal keeps their current value
+	for key, field := range map[string]**bool{
+		"force_www_enabled":           &updatedEdgeLogic.ForceWwwEnabled,
+		"pseudo_streaming_enabled":    &updatedEdgeLogic.PseudoStreamingEnabled,
+		"referrer_protection_enabled": &updatedEdgeLogic.ReferrerProtectionEnabled,
+		"allow_empty_referrer":        &updatedEdgeLogic.AllowEmptyReferrer,
+		"robot_txt_enabled":           &updatedEdgeLogic.RobotTxtEnabled,
+	} {
+		value := d.Get(key).(string)
+		if value != "" {
+			boolValue, _ := strconv.ParseBool(value)
+			*field = utils.BoolAddr(boolValue)
+		}
+	}
+
+	for _, val := range d.Get("referrer_list").([]interface{}) {
+		updatedEdgeLogic.ReferrerList = append(updatedEdgeLogic.ReferrerList, val.(string))
+	}
+
+	return updatedEdgeLogic
+}
+
+func convertEdgeLogicAPIObjectToResourceData(d *schema.ResourceData, edgeLogic *apiclient.EdgeLogic) {
+	//Store the data
+	d.Set("site_id", edgeLogic.Id)
+	d.Set("stack_id", edgeLogic.StackId)
+	d.Set("scope_id", edgeLogic.ScopeId)
+	d.Set("force_www_enabled", utils.CheckAndConvertBool(edgeLogic.ForceWwwEnabled))
+	d.Set("pseudo_streaming_enabled", utils.CheckAndConvertBool(edgeLogic.PseudoStreamingEnabled))
+	d.Set("referrer_protection_enabled", utils.CheckAndConvertBool(edgeLogic.ReferrerProtectionEnabled))
+	d.Set("allow_empty_referrer", utils.CheckAndConvertBool(edgeLogic.AllowEmptyReferrer))
+	d.Set("referrer_list", edgeLogic.ReferrerList)
+	d.Set("robot_txt_enabled", utils.CheckAndConvertBool(edgeLogic.RobotTxtEnabled))
+	d.Set("robot_txt_file", edgeLogic.RobotTxtFile)
+}
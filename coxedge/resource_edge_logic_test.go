@@ -0,0 +1,127 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+package coxedge
+
+import (
+	"context"
+	"coxedge/terraform-provider/coxedge/apiclient"
+	"coxedge/terraform-provider/coxedge/utils"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"regexp"
+	"testing"
+)
+
+func TestAccEdgeLogic(t *testing.T) {
+	siteDomain := testAccName("tf-acc-site") + ".example.com"
+	otherSiteDomain := testAccName("tf-acc-site") + ".example.com"
+	resourceName := "coxedge_edge_logic.test"
+	var rs *terraform.ResourceState
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		//Edge logic lives as long as its site, destroying it only drops it from state
+		CheckDestroy: testAccCheckDestroyed("coxedge_site", testAccSiteExists),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEdgeLogicConfig(siteDomain, "false", "example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "site_id", "coxedge_site.test", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "stack_id"),
+					resource.TestCheckResourceAttr(resourceName, "force_www_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "referrer_protection_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "referrer_list.0", "example.com"),
+				),
+			},
+			{
+				Config: testAccEdgeLogicConfig(siteDomain, "true", "cdn.example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "force_www_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "referrer_list.0", "cdn.example.com"),
+					testAccStoreResourceState(resourceName, &rs),
+				),
+			},
+			{
+				Config:   testAccEdgeLogicConfig(siteDomain, "true", "cdn.example.com"),
+				PlanOnly: true,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccCompositeImportId(resourceName, false),
+				ImportStateVerify: true,
+			},
+			{
+				//Settings changed outside of Terraform are planned to be changed back
+				PreConfig: func() {
+					testAccChangeEdgeLogicForceWww(t, rs)
+				},
+				Config:             testAccEdgeLogicConfig(siteDomain, "true", "cdn.example.com"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				//Edge logic is keyed by its site, so moving it to another site replaces it
+				Config: testAccEdgeLogicOtherSiteConfig(siteDomain, otherSiteDomain),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id", "coxedge_site.other", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "site_id", "coxedge_site.other", "id"),
+				),
+			},
+			{
+				ResourceName:  resourceName,
+				ImportState:   true,
+				ImportStateId: "site:environment",
+				ExpectError:   regexp.MustCompile("unexpected import ID"),
+			},
+		},
+	})
+}
+
+func testAccChangeEdgeLogicForceWww(t *testing.T, rs *terraform.ResourceState) {
+	edgeLogic := apiclient.EdgeLogic{EnvironmentName: rs.Primary.Attributes["environment_name"], ForceWwwEnabled: utils.BoolAddr(false)}
+	_, err := testAccClient().UpdateEdgeLogic(context.Background(), rs.Primary.ID, edgeLogic, rs.Primary.Attributes["organization_id"])
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testAccEdgeLogicConfig(siteDomain string, forceWww string, referrer string) string {
+	return testAccProviderConfig() + testAccSiteResource(siteDomain, "") + fmt.Sprintf(`
+resource "coxedge_edge_logic" "test" {
+  organization_id             = coxedge_site.test.organization_id
+  environment_name            = coxedge_site.test.environment_name
+  site_id                     = coxedge_site.test.id
+  force_www_enabled           = %q
+  referrer_protection_enabled = "true"
+  referrer_list               = [%q]
+}
+`, forceWww, referrer)
+}
+
+func testAccEdgeLogicOtherSiteConfig(siteDomain string, otherSiteDomain string) string {
+	return testAccProviderConfig() + testAccSiteResource(siteDomain, "") + fmt.Sprintf(`
+resource "coxedge_site" "other" {
+  organization_id  = coxedge_site.test.organization_id
+  environment_name = coxedge_site.test.environment_name
+  domain           = %q
+  hostname         = "192.0.2.11"
+  protocol         = "HTTPS"
+  services         = ["CDN", "SERVERLESS_EDGE_ENGINE", "WAF"]
+}
+
+resource "coxedge_edge_logic" "test" {
+  organization_id             = coxedge_site.other.organization_id
+  environment_name            = coxedge_site.other.environment_name
+  site_id                     = coxedge_site.other.id
+  force_www_enabled           = "true"
+  referrer_protection_enabled = "true"
+  referrer_list               = ["cdn.example.com"]
+}
+`, otherSiteDomain)
+}
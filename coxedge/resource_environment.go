@@ -13,6 +13,9 @@ import (
 	"time"
 )
 
+//environmentSettleDelay Wait after creating an environment before its membership can be changed
+var environmentSettleDelay = 10 * time.Second
+
 func resourceEnvironment() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceEnvironmentCreate,
@@ -22,27 +25,31 @@ func resourceEnvironment() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
-		Schema: getEnvironmentSchema(),
+		Schema:        getEnvironmentSchema(),
+		CustomizeDiff: customizeDiffProviderDefaults("organization_id"),
 	}
 }
 
 func resourceEnvironmentCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	//Get the API Client
-	coxEdgeClient := m.(apiclient.Client)
+	coxEdgeClient := m.(apiclient.API)
 
-	// Warning or errors can be collected in a slice type
-	var diags diag.Diagnostics
 	//Convert resource data to API Object
 	newEnvironment := convertResourceDataToEnvironmentCreateAPIObject(ctx, d)
 	//Call the API
-	createdEnvironment, err := coxEdgeClient.CreateEnvironment(newEnvironment)
+	createdEnvironment, err := coxEdgeClient.CreateEnvironment(ctx, newEnvironment)
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	time.Sleep(time.Second * 10)
+	//Give the environment time to settle before changing its membership
+	select {
+	case <-ctx.Done():
+		return diag.FromErr(ctx.Err())
+	case <-time.After(environmentSettleDelay):
+	}
 	if _, hasMembershipValue := d.GetOk("membership"); hasMembershipValue {
 		membership := convertResourceDataToEnvironmentMembership(d)
-		_, err = coxEdgeClient.UpdateEnvironmentMembership(createdEnvironment.Id, membership)
+		_, err = coxEdgeClient.UpdateEnvironmentMembership(ctx, createdEnvironment.Id, membership)
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -51,12 +58,12 @@ func resourceEnvironmentCreate(ctx context.Context, d *schema.ResourceData, m in
 	//Save the Id
 	d.SetId(createdEnvironment.Id)
 
-	return diags
+	return resourceEnvironmentRead(ctx, d, m)
 }
 
 func resourceEnvironmentRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	//Get the API Client
-	coxEdgeClient := m.(apiclient.Client)
+	coxEdgeClient := m.(apiclient.API)
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -65,7 +72,12 @@ func resourceEnvironmentRead(ctx context.Context, d *schema.ResourceData, m inte
 	resourceId := d.Id()
 
 	//Get the resource
-	environment, err := coxEdgeClient.GetEnvironment(resourceId)
+	environment, err := coxEdgeClient.GetEnvironment(ctx, resourceId)
+	if apiclient.IsNotFound(err) {
+		//Removed outside of Terraform, drop it from state so it is recreated
+		d.SetId("")
+		return diags
+	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -77,22 +89,33 @@ func resourceEnvironmentRead(ctx context.Context, d *schema.ResourceData, m inte
 
 func resourceEnvironmentUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	//Get the API Client
-	coxEdgeClient := m.(apiclient.Client)
+	coxEdgeClient := m.(apiclient.API)
 
 	//Get the resource Id
 	resourceId := d.Id()
 
+	//Members are bound in parallel by coxedge_environment_member
+	defer environmentLocks.Lock(resourceId)()
+
 	//Convert resource data to API object
 	updatedEnvironment := convertResourceDataToEnvironmentCreateAPIObject(ctx, d)
 
+	//Keep the members bound outside of this resource, the roles are sent back whole
+	environment, err := coxEdgeClient.GetEnvironment(ctx, resourceId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	oldRoles, newRoles := d.GetChange("roles")
+	keepUnlistedEnvironmentMembers(&updatedEnvironment, environment, listedEnvironmentUsers(oldRoles, newRoles))
+
 	//Call the API
-	_, err := coxEdgeClient.UpdateEnvironment(resourceId, updatedEnvironment)
+	_, err = coxEdgeClient.UpdateEnvironment(ctx, resourceId, updatedEnvironment)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 	if _, hasMembershipValue := d.GetOk("membership"); hasMembershipValue {
 		membership := convertResourceDataToEnvironmentMembership(d)
-		_, err = coxEdgeClient.UpdateEnvironmentMembership(resourceId, membership)
+		_, err = coxEdgeClient.UpdateEnvironmentMembership(ctx, resourceId, membership)
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -109,12 +132,12 @@ func resourceEnvironmentDelete(ctx context.Context, d *schema.ResourceData, m in
 	var diags diag.Diagnostics
 
 	//Get the API Client
-	coxEdgeClient := m.(apiclient.Client)
+	coxEdgeClient := m.(apiclient.API)
 
 	//Get the resource Id
 	resourceId := d.Id()
 	//Delete the Environment
-	err := coxEdgeClient.DeleteEnvironment(resourceId)
+	err := coxEdgeClient.DeleteEnvironment(ctx, resourceId)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -170,6 +193,39 @@ func convertResourceDataToEnvironmentCreateAPIObject(ctx context.Context, d *sch
 	return updatedEnvironment
 }
 
+//listedEnvironmentUsers The users listed in the roles of the configuration, before and after the change
+func listedEnvironmentUsers(rolesValues ...interface{}) map[string]bool {
+	listed := map[string]bool{}
+	for _, rolesValue := range rolesValues {
+		for _, rawRole := range rolesValue.([]interface{}) {
+			rr := rawRole.(map[string]interface{})
+			for _, rawUser := range rr["users"].([]interface{}) {
+				listed[rawUser.(string)] = true
+			}
+		}
+	}
+	return listed
+}
+
+//keepUnlistedEnvironmentMembers Add the users the environment holds but the configuration never listed back to their
+//role in the update, so members bound by coxedge_environment_member survive an update of the environment
+func keepUnlistedEnvironmentMembers(updatedEnvironment *apiclient.EnvironmentCreateRequest, environment *apiclient.Environment, listed map[string]bool) {
+	for _, role := range environment.Roles {
+		for i := range updatedEnvironment.Roles {
+			if updatedEnvironment.Roles[i].Name != role.Name {
+				continue
+			}
+			//Members are bound to the role id, keep it
+			updatedEnvironment.Roles[i].Id = role.Id
+			for _, user := range role.Users {
+				if !listed[user.Id] {
+					updatedEnvironment.Roles[i].Users = append(updatedEnvironment.Roles[i].Users, user)
+				}
+			}
+		}
+	}
+}
+
 func convertEnvironmentAPIObjectToResourceData(d *schema.ResourceData, environment *apiclient.Environment) {
 	//Store the data
 	d.Set("id", environment.Id)
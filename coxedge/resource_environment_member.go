@@ -0,0 +1,192 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+package coxedge
+
+import (
+	"context"
+	"coxedge/terraform-provider/coxedge/apiclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"strings"
+)
+
+func resourceEnvironmentMember() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceEnvironmentMemberCreate,
+		ReadContext:   resourceEnvironmentMemberRead,
+		UpdateContext: resourceEnvironmentMemberUpdate,
+		DeleteContext: resourceEnvironmentMemberDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: getEnvironmentMemberSchema(),
+	}
+}
+
+func resourceEnvironmentMemberCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	defer environmentLocks.Lock(d.Get("environment_id").(string))()
+
+	//Bind the user to the role
+	if diags := updateEnvironmentMember(ctx, d, m); diags.HasError() {
+		return diags
+	}
+
+	//Save the Id, a user holds a single role per environment
+	d.SetId(d.Get("environment_id").(string) + ":" + d.Get("user_id").(string))
+
+	return resourceEnvironmentMemberRead(ctx, d, m)
+}
+
+func resourceEnvironmentMemberRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	//Get the API Client
+	coxEdgeClient := m.(apiclient.API)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	//the id is <environment_id>:<user_id>, which is also the import format
+	keys := strings.Split(d.Id(), ":")
+	if len(keys) != 2 {
+		return diag.Errorf("unexpected ID %q, expected <environment_id>:<user_id>", d.Id())
+	}
+	environmentId, userId := keys[0], keys[1]
+
+	//Get the environment
+	environment, err := coxEdgeClient.GetEnvironment(ctx, environmentId)
+	if apiclient.IsNotFound(err) {
+		//Removed outside of Terraform, drop it from state so it is recreated
+		d.SetId("")
+		return diags
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	//Find the role holding the user
+	for _, role := range environment.Roles {
+		for _, user := range role.Users {
+			if user.Id == userId {
+				d.Set("environment_id", environmentId)
+				d.Set("user_id", userId)
+				d.Set("role_id", role.Id)
+				d.Set("role_name", role.Name)
+				return diags
+			}
+		}
+	}
+
+	//Removed from the environment outside of Terraform
+	d.SetId("")
+	return diags
+}
+
+func resourceEnvironmentMemberUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	defer environmentLocks.Lock(d.Get("environment_id").(string))()
+
+	//Moving the user to another role replaces its current one
+	if diags := updateEnvironmentMember(ctx, d, m); diags.HasError() {
+		return diags
+	}
+
+	return resourceEnvironmentMemberRead(ctx, d, m)
+}
+
+func resourceEnvironmentMemberDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	//Get the API Client
+	coxEdgeClient := m.(apiclient.API)
+
+	environmentId := d.Get("environment_id").(string)
+	userId := d.Get("user_id").(string)
+
+	//Other members of the environment are changed in parallel, each read and write of it must not interleave
+	defer environmentLocks.Lock(environmentId)()
+
+	//Get the environment
+	environment, err := coxEdgeClient.GetEnvironment(ctx, environmentId)
+	if apiclient.IsNotFound(err) {
+		d.SetId("")
+		return diags
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	//The API has no call removing a single member, send the environment back without the user
+	_, err = coxEdgeClient.UpdateEnvironment(ctx, environmentId, convertEnvironmentToUpdateWithoutMember(environment, userId))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	// From Docs: d.SetId("") is automatically called assuming delete returns no errors, but
+	// it is added here for explicitness.
+	d.SetId("")
+
+	return diags
+}
+
+//updateEnvironmentMember Bind the user to the environment role named in role_name
+func updateEnvironmentMember(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	//Get the API Client
+	coxEdgeClient := m.(apiclient.API)
+
+	environmentId := d.Get("environment_id").(string)
+	roleName := d.Get("role_name").(string)
+
+	//Roles are named in configurations, the API binds users by role id
+	environment, err := coxEdgeClient.GetEnvironment(ctx, environmentId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	roleId := ""
+	for _, role := range environment.Roles {
+		if role.Name == roleName {
+			roleId = role.Id
+			break
+		}
+	}
+	if roleId == "" {
+		return diag.Errorf("environment %s has no role named %q", environmentId, roleName)
+	}
+
+	//Call the API
+	member := apiclient.EnvironmentMembersRequest{
+		User: apiclient.IdOnlyHelper{Id: d.Get("user_id").(string)},
+		Role: apiclient.IdOnlyHelper{Id: roleId},
+	}
+	_, err = coxEdgeClient.UpdateEnvironmentMember(ctx, environmentId, member)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+//convertEnvironmentToUpdateWithoutMember Update request keeping the environment as is, except for the user dropped from its roles
+func convertEnvironmentToUpdateWithoutMember(environment *apiclient.Environment, userId string) apiclient.EnvironmentCreateRequest {
+	updatedEnvironment := apiclient.EnvironmentCreateRequest{
+		EnvironmentName:   environment.Name,
+		Description:       environment.Description,
+		Organization:      apiclient.IdOnlyHelper{Id: environment.Organization.Id},
+		ServiceConnection: apiclient.IdOnlyHelper{Id: environment.ServiceConnection.Id},
+	}
+	for _, role := range environment.Roles {
+		newRole := apiclient.Role{
+			Id:        role.Id,
+			Name:      role.Name,
+			IsDefault: role.IsDefault,
+			Users:     []apiclient.IdOnlyHelper{},
+		}
+		for _, user := range role.Users {
+			if user.Id != userId {
+				newRole.Users = append(newRole.Users, user)
+			}
+		}
+		updatedEnvironment.Roles = append(updatedEnvironment.Roles, newRole)
+	}
+	return updatedEnvironment
+}
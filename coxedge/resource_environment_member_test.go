@@ -0,0 +1,286 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+package coxedge
+
+import (
+	"context"
+	"coxedge/terraform-provider/coxedge/apiclient"
+	"coxedge/terraform-provider/coxedge/apiclient/fake"
+	"coxedge/terraform-provider/coxedge/apiclient/mock"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestAccEnvironmentMember(t *testing.T) {
+	environmentName := testAccName("tf-acc-env")
+	userName := testAccName("tf-acc-user")
+	roleId := testAccRoleId(t)
+	resourceName := "coxedge_environment_member.test"
+	var rs *terraform.ResourceState
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckDestroyed("coxedge_environment_member", testAccEnvironmentMemberExists),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentMemberConfig(environmentName, userName, roleId, "Developer", "Members"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "environment_id", "coxedge_environment.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "user_id", "coxedge_user.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "role_name", "Developer"),
+					resource.TestCheckResourceAttrSet(resourceName, "role_id"),
+				),
+			},
+			{
+				//Moving the user to another role keeps the binding
+				Config: testAccEnvironmentMemberConfig(environmentName, userName, roleId, "Viewer", "Members"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "role_name", "Viewer"),
+				),
+			},
+			{
+				//Updating the environment keeps the members it does not list
+				Config: testAccEnvironmentMemberConfig(environmentName, userName, roleId, "Viewer", "Updated"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("coxedge_environment.test", "description", "Updated"),
+					testAccCheckEnvironmentMemberRole(resourceName, "Viewer"),
+					testAccStoreResourceState(resourceName, &rs),
+				),
+			},
+			{
+				Config:   testAccEnvironmentMemberConfig(environmentName, userName, roleId, "Viewer", "Updated"),
+				PlanOnly: true,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				//A member removed outside of Terraform is planned to be added again
+				PreConfig: func() {
+					testAccRemoveEnvironmentMember(t, rs)
+				},
+				Config:             testAccEnvironmentMemberConfig(environmentName, userName, roleId, "Viewer", "Updated"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config:      testAccEnvironmentMemberConfig(environmentName, userName, roleId, "Missing", "Members"),
+				ExpectError: regexp.MustCompile(`has no role named "Missing"`),
+			},
+		},
+	})
+}
+
+//testAccEnvironmentMemberExists Fails with a not found error once the user is no longer in any role of the environment
+func testAccEnvironmentMemberExists(ctx context.Context, client *apiclient.Client, rs *terraform.ResourceState) error {
+	keys := strings.Split(rs.Primary.ID, ":")
+	environment, err := client.GetEnvironment(ctx, keys[0])
+	if err != nil {
+		return err
+	}
+	for _, role := range environment.Roles {
+		for _, user := range role.Users {
+			if user.Id == keys[1] {
+				return nil
+			}
+		}
+	}
+	return &apiclient.APIError{StatusCode: 404}
+}
+
+func testAccRemoveEnvironmentMember(t *testing.T, rs *terraform.ResourceState) {
+	ctx := context.Background()
+	client := testAccClient()
+	environmentId := rs.Primary.Attributes["environment_id"]
+	environment, err := client.GetEnvironment(ctx, environmentId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.UpdateEnvironment(ctx, environmentId, convertEnvironmentToUpdateWithoutMember(environment, rs.Primary.Attributes["user_id"]))
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+//testAccCheckEnvironmentMemberRole Check through the API that the member still holds the role
+func testAccCheckEnvironmentMemberRole(resourceName string, roleName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("%s not found in state", resourceName)
+		}
+		keys := strings.Split(rs.Primary.ID, ":")
+		environment, err := testAccClient().GetEnvironment(context.Background(), keys[0])
+		if err != nil {
+			return err
+		}
+		for _, role := range environment.Roles {
+			for _, user := range role.Users {
+				if user.Id == keys[1] && role.Name == roleName {
+					return nil
+				}
+			}
+		}
+		return fmt.Errorf("user %s is no longer in role %s of environment %s", keys[1], roleName, keys[0])
+	}
+}
+
+func testAccEnvironmentMemberConfig(environmentName string, userName string, roleId string, roleName string, description string) string {
+	return testAccProviderConfig() + fmt.Sprintf(`
+resource "coxedge_environment" "test" {
+  name                  = %[1]q
+  description           = %[7]q
+  organization_id       = %[3]q
+  service_connection_id = %[4]q
+  roles {
+    name  = "Developer"
+    users = []
+  }
+  roles {
+    name  = "Viewer"
+    users = []
+  }
+}
+
+resource "coxedge_user" "test" {
+  user_name       = %[2]q
+  first_name      = "Acceptance"
+  last_name       = "Test"
+  email           = "%[2]s@example.com"
+  organization_id = %[3]q
+  roles {
+    id = %[5]q
+  }
+}
+
+resource "coxedge_environment_member" "test" {
+  environment_id = coxedge_environment.test.id
+  user_id        = coxedge_user.test.id
+  role_name      = %[6]q
+}
+`, environmentName, userName, testAccOrganizationId(), testAccServiceConnectionId(), roleId, roleName, description)
+}
+
+func TestEnvironmentMemberDelete(t *testing.T) {
+	var sent apiclient.EnvironmentCreateRequest
+	client := &mock.APIMock{
+		GetEnvironmentFunc: func(ctx context.Context, id string) (*apiclient.Environment, error) {
+			return &apiclient.Environment{
+				Id:                id,
+				Name:              "test-env",
+				Organization:      apiclient.Organization{Id: "org-1"},
+				ServiceConnection: apiclient.ServiceConnection{Id: "service-1"},
+				Roles: []apiclient.Role{
+					{Id: "role-1", Name: "Developer", Users: []apiclient.IdOnlyHelper{{Id: "user-1"}, {Id: "user-2"}}},
+					{Id: "role-2", Name: "Viewer", Users: []apiclient.IdOnlyHelper{{Id: "user-3"}}},
+				},
+			}, nil
+		},
+		UpdateEnvironmentFunc: func(ctx context.Context, id string, request apiclient.EnvironmentCreateRequest) (*apiclient.Environment, error) {
+			if id != "env-1" {
+				t.Errorf("unexpected environment id %q", id)
+			}
+			sent = request
+			return &apiclient.Environment{}, nil
+		},
+	}
+	d := schema.TestResourceDataRaw(t, getEnvironmentMemberSchema(), map[string]interface{}{
+		"environment_id": "env-1",
+		"user_id":        "user-1",
+		"role_name":      "Developer",
+	})
+	d.SetId("env-1:user-1")
+
+	diags := resourceEnvironmentMemberDelete(context.Background(), d, client)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	if d.Id() != "" {
+		t.Errorf("expected the member to be removed from state, got id %q", d.Id())
+	}
+	if sent.EnvironmentName != "test-env" || sent.Organization.Id != "org-1" || sent.ServiceConnection.Id != "service-1" {
+		t.Errorf("expected the environment to be sent back unchanged, got %+v", sent)
+	}
+	if fmt.Sprint(sent.Roles) != fmt.Sprint([]apiclient.Role{
+		{Id: "role-1", Name: "Developer", Users: []apiclient.IdOnlyHelper{{Id: "user-2"}}},
+		{Id: "role-2", Name: "Viewer", Users: []apiclient.IdOnlyHelper{{Id: "user-3"}}},
+	}) {
+		t.Errorf("expected only user-1 to be dropped, got %+v", sent.Roles)
+	}
+}
+
+//Members of one environment are destroyed in parallel, neither removal may put the other user back
+func TestEnvironmentMemberDeleteConcurrently(t *testing.T) {
+	server := fake.NewServer()
+	defer server.Close()
+	apiClient := apiclient.NewClient(fake.APIKey, server.URL, fake.ServiceCode)
+
+	environment, err := apiClient.CreateEnvironment(context.Background(), apiclient.EnvironmentCreateRequest{
+		EnvironmentName:   "test-env-members",
+		ServiceConnection: apiclient.IdOnlyHelper{Id: server.ServiceConnectionId},
+		Organization:      apiclient.IdOnlyHelper{Id: server.OrganizationId},
+		Roles:             []apiclient.Role{{Name: "Developer"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	userIds := []string{"user-1", "user-2"}
+	for _, userId := range userIds {
+		member := apiclient.EnvironmentMembersRequest{
+			User: apiclient.IdOnlyHelper{Id: userId},
+			Role: apiclient.IdOnlyHelper{Id: environment.Roles[0].Id},
+		}
+		if _, err = apiClient.UpdateEnvironmentMember(context.Background(), environment.Id, member); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	//Slow reads leave both deletes holding the environment at the same time unless they are serialized
+	client := &mock.APIMock{
+		GetEnvironmentFunc: func(ctx context.Context, id string) (*apiclient.Environment, error) {
+			current, err := apiClient.GetEnvironment(ctx, id)
+			time.Sleep(50 * time.Millisecond)
+			return current, err
+		},
+		UpdateEnvironmentFunc: apiClient.UpdateEnvironment,
+	}
+
+	var wg sync.WaitGroup
+	for _, userId := range userIds {
+		d := schema.TestResourceDataRaw(t, getEnvironmentMemberSchema(), map[string]interface{}{
+			"environment_id": environment.Id,
+			"user_id":        userId,
+			"role_name":      "Developer",
+		})
+		d.SetId(environment.Id + ":" + userId)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if diags := resourceEnvironmentMemberDelete(context.Background(), d, client); diags.HasError() {
+				t.Error(diags)
+			}
+		}()
+	}
+	wg.Wait()
+
+	updated, err := apiClient.GetEnvironment(context.Background(), environment.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(updated.Roles[0].Users) != 0 {
+		t.Errorf("expected both users to be removed, got %+v", updated.Roles[0].Users)
+	}
+}
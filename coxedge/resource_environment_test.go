@@ -0,0 +1,105 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+package coxedge
+
+import (
+	"context"
+	"coxedge/terraform-provider/coxedge/apiclient"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"testing"
+)
+
+func TestAccEnvironment(t *testing.T) {
+	name := testAccName("tf-acc-env")
+	resourceName := "coxedge_environment.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckDestroyed("coxedge_environment", testAccEnvironmentExists),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentConfig(name, "Terraform acceptance test"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "description", "Terraform acceptance test"),
+					resource.TestCheckResourceAttr(resourceName, "organization_id", testAccOrganizationId()),
+					resource.TestCheckResourceAttrSet(resourceName, "creation_date"),
+				),
+			},
+			{
+				Config: testAccEnvironmentConfig(name, "Terraform acceptance test, updated"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "description", "Terraform acceptance test, updated"),
+				),
+			},
+			{
+				//Nothing may drift between applies
+				Config:   testAccEnvironmentConfig(name, "Terraform acceptance test, updated"),
+				PlanOnly: true,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				//Only set by updates
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			{
+				//A change made outside of Terraform shows up in the plan
+				PreConfig: func() {
+					testAccChangeEnvironmentDescription(t, name, "Changed outside of Terraform")
+				},
+				Config:             testAccEnvironmentConfig(name, "Terraform acceptance test, updated"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccEnvironmentExists(ctx context.Context, client *apiclient.Client, rs *terraform.ResourceState) error {
+	_, err := client.GetEnvironment(ctx, rs.Primary.ID)
+	return err
+}
+
+func testAccChangeEnvironmentDescription(t *testing.T, name string, description string) {
+	ctx := context.Background()
+	client := testAccClient()
+	environments, err := client.GetEnvironments(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, environment := range environments {
+		if environment.Name != name {
+			continue
+		}
+		_, err = client.UpdateEnvironment(ctx, environment.Id, apiclient.EnvironmentCreateRequest{
+			EnvironmentName:   environment.Name,
+			Description:       description,
+			Organization:      apiclient.IdOnlyHelper{Id: environment.Organization.Id},
+			ServiceConnection: apiclient.IdOnlyHelper{Id: environment.ServiceConnection.Id},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	t.Fatalf("environment %s not found", name)
+}
+
+func testAccEnvironmentConfig(name string, description string) string {
+	return testAccProviderConfig() + fmt.Sprintf(`
+resource "coxedge_environment" "test" {
+  name                  = %q
+  description           = %q
+  organization_id       = %q
+  service_connection_id = %q
+}
+`, name, description, testAccOrganizationId(), testAccServiceConnectionId())
+}
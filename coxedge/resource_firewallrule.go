@@ -10,6 +10,7 @@ import (
 	"coxedge/terraform-provider/coxedge/apiclient"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"strings"
 	"time"
 )
 
@@ -22,13 +23,19 @@ func resourceFirewallRule() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
-		Schema: getFirewallRuleSchema(),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema:        getFirewallRuleSchema(),
+		CustomizeDiff: customizeDiffProviderDefaults("organization_id", "environment_name"),
 	}
 }
 
 func resourceFirewallRuleCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	//Get the API Client
-	coxEdgeClient := m.(apiclient.Client)
+	coxEdgeClient := m.(apiclient.API)
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -38,35 +45,54 @@ func resourceFirewallRuleCreate(ctx context.Context, d *schema.ResourceData, m i
 
 	organizationId := d.Get("organization_id").(string)
 	//Call the API
-	createdFirewallRule, err := coxEdgeClient.CreateFirewallRule(d.Get("environment_name").(string), newFirewallRule, organizationId)
+	createdFirewallRule, err := coxEdgeClient.CreateFirewallRule(ctx, d.Get("environment_name").(string), newFirewallRule, organizationId)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	//Await
-	taskResult, err := coxEdgeClient.AwaitTaskResolveWithDefaults(ctx, createdFirewallRule.TaskId)
-	if err != nil {
-		return diag.FromErr(err)
+	//Await, recording the task in state so an interrupted apply can resume it
+	if diags = awaitCreateTask(ctx, d, coxEdgeClient, createdFirewallRule.TaskId, "coxedge_firewall_rule", d.Get("name").(string)); diags != nil {
+		return diags
 	}
 
-	//Save the Id
-	d.SetId(taskResult.Data.Result.Id)
-
-	return diags
+	return resourceFirewallRuleRead(ctx, d, m)
 }
 
 func resourceFirewallRuleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	//Get the API Client
-	coxEdgeClient := m.(apiclient.Client)
+	coxEdgeClient := m.(apiclient.API)
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
+	//check the id comes with id, site_id, environment_name & organization_id, then split the value -> in case of importing the resource
+	//format is <firewall_rule_id>:<site_id>:<environment_name>:<organization_id>
+	if strings.Contains(d.Id(), ":") {
+		keys := strings.Split(d.Id(), ":")
+		if len(keys) != 4 {
+			return diag.Errorf("unexpected import ID %q, expected <firewall_rule_id>:<site_id>:<environment_name>:<organization_id>", d.Id())
+		}
+		d.SetId(keys[0])
+		d.Set("site_id", keys[1])
+		d.Set("environment_name", keys[2])
+		d.Set("organization_id", keys[3])
+	}
+
+	//Resume a create that was interrupted while awaiting its task
+	if diags = resumePendingTask(ctx, d, coxEdgeClient, "coxedge_firewall_rule", d.Get("name").(string)); diags.HasError() || d.Id() == "" {
+		return diags
+	}
+
 	//Get the resource Id
 	resourceId := d.Id()
 	organizationId := d.Get("organization_id").(string)
 	//Get the resource
-	firewallRule, err := coxEdgeClient.GetFirewallRule(d.Get("environment_name").(string), d.Get("site_id").(string), resourceId, organizationId)
+	firewallRule, err := coxEdgeClient.GetFirewallRule(ctx, d.Get("environment_name").(string), d.Get("site_id").(string), resourceId, organizationId)
+	if apiclient.IsNotFound(err) {
+		//Removed outside of Terraform, drop it from state so it is recreated
+		d.SetId("")
+		return diags
+	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -78,7 +104,7 @@ func resourceFirewallRuleRead(ctx context.Context, d *schema.ResourceData, m int
 
 func resourceFirewallRuleUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	//Get the API Client
-	coxEdgeClient := m.(apiclient.Client)
+	coxEdgeClient := m.(apiclient.API)
 
 	//Get the resource Id
 	resourceId := d.Id()
@@ -87,7 +113,7 @@ func resourceFirewallRuleUpdate(ctx context.Context, d *schema.ResourceData, m i
 	updatedFirewallRule := convertResourceDataToFirewallRuleCreateAPIObject(d)
 	organizationId := d.Get("organization_id").(string)
 	//Call the API
-	_, err := coxEdgeClient.UpdateFirewallRule(d.Get("environment_name").(string), resourceId, updatedFirewallRule, organizationId)
+	_, err := coxEdgeClient.UpdateFirewallRule(ctx, d.Get("environment_name").(string), resourceId, updatedFirewallRule, organizationId)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -103,13 +129,18 @@ func resourceFirewallRuleDelete(ctx context.Context, d *schema.ResourceData, m i
 	var diags diag.Diagnostics
 
 	//Get the API Client
-	coxEdgeClient := m.(apiclient.Client)
+	coxEdgeClient := m.(apiclient.API)
+
+	//Resolve an interrupted create first, so the created object is deleted rather than the task
+	if diags = resumePendingTask(ctx, d, coxEdgeClient, "coxedge_firewall_rule", d.Get("name").(string)); diags.HasError() || d.Id() == "" {
+		return diags
+	}
 
 	//Get the resource Id
 	resourceId := d.Id()
 	organizationId := d.Get("organization_id").(string)
 	//Delete the FirewallRule
-	err := coxEdgeClient.DeleteFirewallRule(d.Get("environment_name").(string), d.Get("site_id").(string), resourceId, organizationId)
+	err := coxEdgeClient.DeleteFirewallRule(ctx, d.Get("environment_name").(string), d.Get("site_id").(string), resourceId, organizationId)
 	if err != nil {
 		return diag.FromErr(err)
 	}
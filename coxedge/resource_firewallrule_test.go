@@ -0,0 +1,127 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+package coxedge
+
+import (
+	"context"
+	"coxedge/terraform-provider/coxedge/apiclient"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"testing"
+)
+
+func TestAccFirewallRule(t *testing.T) {
+	siteDomain := testAccName("tf-acc-site") + ".example.com"
+	name := testAccName("tf-acc-firewall")
+	resourceName := "coxedge_firewall_rule.test"
+	var rs *terraform.ResourceState
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckDestroyed("coxedge_firewall_rule", testAccFirewallRuleExists),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFirewallRuleConfig(siteDomain, name, "ALLOW"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "action", "ALLOW"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttrPair(resourceName, "site_id", "coxedge_site.test", "id"),
+				),
+			},
+			{
+				Config: testAccFirewallRuleConfig(siteDomain, name, "BLOCK"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "action", "BLOCK"),
+					testAccStoreResourceState(resourceName, &rs),
+				),
+			},
+			{
+				Config:   testAccFirewallRuleConfig(siteDomain, name, "BLOCK"),
+				PlanOnly: true,
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateIdFunc:       testAccCompositeImportId(resourceName, true),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated", "pending_task_id"},
+			},
+			{
+				//A rule changed outside of Terraform is planned to be changed back
+				PreConfig: func() {
+					testAccChangeFirewallRuleAction(t, rs)
+				},
+				Config:             testAccFirewallRuleConfig(siteDomain, name, "BLOCK"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccFirewallRuleListCache(t *testing.T) {
+	t.Setenv("COXEDGE_ENABLE_LIST_CACHE", "true")
+	siteDomain := testAccName("tf-acc-site") + ".example.com"
+	name := testAccName("tf-acc-firewall")
+	resourceName := "coxedge_firewall_rule.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckDestroyed("coxedge_firewall_rule", testAccFirewallRuleExists),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFirewallRuleConfig(siteDomain, name, "ALLOW"),
+				Check:  resource.TestCheckResourceAttr(resourceName, "action", "ALLOW"),
+			},
+			{
+				Config: testAccFirewallRuleConfig(siteDomain, name, "BLOCK"),
+				Check:  resource.TestCheckResourceAttr(resourceName, "action", "BLOCK"),
+			},
+			{
+				Config:   testAccFirewallRuleConfig(siteDomain, name, "BLOCK"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccFirewallRuleExists(ctx context.Context, client *apiclient.Client, rs *terraform.ResourceState) error {
+	_, err := client.GetFirewallRule(ctx, rs.Primary.Attributes["environment_name"], rs.Primary.Attributes["site_id"], rs.Primary.ID, rs.Primary.Attributes["organization_id"])
+	return err
+}
+
+func testAccChangeFirewallRuleAction(t *testing.T, rs *terraform.ResourceState) {
+	ctx := context.Background()
+	client := testAccClient()
+	environmentName := rs.Primary.Attributes["environment_name"]
+	organizationId := rs.Primary.Attributes["organization_id"]
+	rule, err := client.GetFirewallRule(ctx, environmentName, rs.Primary.Attributes["site_id"], rs.Primary.ID, organizationId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rule.Action = "ALLOW"
+	if _, err = client.UpdateFirewallRule(ctx, environmentName, rs.Primary.ID, *rule, organizationId); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testAccFirewallRuleConfig(siteDomain string, name string, action string) string {
+	return testAccProviderConfig() + testAccSiteResource(siteDomain, "") + fmt.Sprintf(`
+resource "coxedge_firewall_rule" "test" {
+  organization_id  = coxedge_site.test.organization_id
+  environment_name = coxedge_site.test.environment_name
+  site_id          = coxedge_site.test.id
+  name             = %q
+  action           = %q
+  ip_start         = "192.0.2.6"
+  ip_end           = "192.0.2.7"
+}
+`, name, action)
+}
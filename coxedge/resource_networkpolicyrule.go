@@ -10,6 +10,7 @@ import (
 	"coxedge/terraform-provider/coxedge/apiclient"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"strings"
 	"time"
 )
 
@@ -22,13 +23,14 @@ func resourceNetworkPolicyRule() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
-		Schema: getNetworkPolicyRuleSchema(),
+		Schema:        getNetworkPolicyRuleSchema(),
+		CustomizeDiff: customizeDiffProviderDefaults("organization_id", "environment_name"),
 	}
 }
 
 func resourceNetworkPolicyRuleCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	//Get the API Client
-	coxEdgeClient := m.(apiclient.Client)
+	coxEdgeClient := m.(apiclient.API)
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -39,7 +41,7 @@ func resourceNetworkPolicyRuleCreate(ctx context.Context, d *schema.ResourceData
 	organizationId := d.Get("organization_id").(string)
 
 	//Call the API
-	createdNetworkPolicyRule, err := coxEdgeClient.CreateNetworkPolicyRule(newNetworkPolicyRule, organizationId)
+	createdNetworkPolicyRule, err := coxEdgeClient.CreateNetworkPolicyRule(ctx, newNetworkPolicyRule, organizationId)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -67,18 +69,35 @@ func resourceNetworkPolicyRuleCreate(ctx context.Context, d *schema.ResourceData
 
 func resourceNetworkPolicyRuleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	//Get the API Client
-	coxEdgeClient := m.(apiclient.Client)
+	coxEdgeClient := m.(apiclient.API)
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
+	//check the id comes with id, environment_name & organization_id, then split the value -> in case of importing the resource
+	//format is <workload_id>:<environment_name>:<organization_id>
+	if strings.Contains(d.Id(), ":") {
+		keys := strings.Split(d.Id(), ":")
+		if len(keys) != 3 {
+			return diag.Errorf("unexpected import ID %q, expected <workload_id>:<environment_name>:<organization_id>", d.Id())
+		}
+		d.SetId(keys[0])
+		d.Set("environment_name", keys[1])
+		d.Set("organization_id", keys[2])
+	}
+
 	//Get the resource Id
 	resourceId := d.Id()
 	organizationId := d.Get("organization_id").(string)
 
 	//Get the resource
-	//networkPolicyRule, err := coxEdgeClient.GetNetworkPolicyRule(d.Get("environment_name").(string), resourceId, organizationId)
-	networkPolicyRule, err := coxEdgeClient.GetNetworkPolicyRuleWorkload(d.Get("environment_name").(string), resourceId, organizationId)
+	//networkPolicyRule, err := coxEdgeClient.GetNetworkPolicyRule(ctx, d.Get("environment_name").(string), resourceId, organizationId)
+	networkPolicyRule, err := coxEdgeClient.GetNetworkPolicyRuleWorkload(ctx, d.Get("environment_name").(string), resourceId, organizationId)
+	if apiclient.IsNotFound(err) {
+		//Removed outside of Terraform, drop it from state so it is recreated
+		d.SetId("")
+		return diags
+	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -91,7 +110,7 @@ func resourceNetworkPolicyRuleRead(ctx context.Context, d *schema.ResourceData,
 
 func resourceNetworkPolicyRuleUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	//Get the API Client
-	coxEdgeClient := m.(apiclient.Client)
+	coxEdgeClient := m.(apiclient.API)
 
 	//Get the resource Id
 	resourceId := d.Id()
@@ -101,7 +120,7 @@ func resourceNetworkPolicyRuleUpdate(ctx context.Context, d *schema.ResourceData
 	updatedNetworkPolicyRule := convertResourceDataToNetworkPolicyRuleCreateAPIObject(d)
 
 	//Call the API
-	updatedRule, err := coxEdgeClient.UpdateNetworkPolicyRule(resourceId, updatedNetworkPolicyRule, organizationId)
+	updatedRule, err := coxEdgeClient.UpdateNetworkPolicyRule(ctx, resourceId, updatedNetworkPolicyRule, organizationId)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -119,7 +138,7 @@ func resourceNetworkPolicyRuleDelete(ctx context.Context, d *schema.ResourceData
 	var diags diag.Diagnostics
 
 	//Get the API Client
-	coxEdgeClient := m.(apiclient.Client)
+	coxEdgeClient := m.(apiclient.API)
 
 	//Get the resource Id
 	resourceId := d.Id()
@@ -145,7 +164,7 @@ func resourceNetworkPolicyRuleDelete(ctx context.Context, d *schema.ResourceData
 	}
 
 	//Delete the NetworkPolicyRule
-	err := coxEdgeClient.DeleteNetworkPolicyRule(d.Get("environment_name").(string), resourceId, organizationId, updatedNetworkPolicyRule)
+	err := coxEdgeClient.DeleteNetworkPolicyRule(ctx, d.Get("environment_name").(string), resourceId, organizationId, updatedNetworkPolicyRule)
 	if err != nil {
 		return diag.FromErr(err)
 	}
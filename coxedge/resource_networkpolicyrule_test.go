@@ -0,0 +1,113 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+package coxedge
+
+import (
+	"context"
+	"coxedge/terraform-provider/coxedge/apiclient"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"testing"
+)
+
+func TestAccNetworkPolicyRule(t *testing.T) {
+	workloadName := testAccName("tf-acc-workload")
+	resourceName := "coxedge_network_policy_rule.test"
+	var rs *terraform.ResourceState
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckNetworkPolicyRuleDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkPolicyRuleConfig(workloadName, "inbound web"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id", "coxedge_workload.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "network_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "network_policy.0.description", "inbound web"),
+					resource.TestCheckResourceAttrSet(resourceName, "network_policy.0.id"),
+				),
+			},
+			{
+				Config: testAccNetworkPolicyRuleConfig(workloadName, "inbound web, updated"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "network_policy.0.description", "inbound web, updated"),
+					testAccStoreResourceState(resourceName, &rs),
+				),
+			},
+			{
+				Config:   testAccNetworkPolicyRuleConfig(workloadName, "inbound web, updated"),
+				PlanOnly: true,
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateIdFunc:       testAccCompositeImportId(resourceName, false),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			{
+				//A rule deleted outside of Terraform is planned to be added again
+				PreConfig: func() {
+					testAccDeleteNetworkPolicyRule(t, rs)
+				},
+				Config:             testAccNetworkPolicyRuleConfig(workloadName, "inbound web, updated"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+//testAccCheckNetworkPolicyRuleDestroyed The resource is addressed by its workload, so it is gone once the workload has
+//no rules left
+func testAccCheckNetworkPolicyRuleDestroyed(s *terraform.State) error {
+	client := testAccClient()
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "coxedge_network_policy_rule" {
+			continue
+		}
+		rules, err := client.GetNetworkPolicyRuleWorkload(context.Background(), rs.Primary.Attributes["environment_name"], rs.Primary.ID, rs.Primary.Attributes["organization_id"])
+		if err != nil {
+			return err
+		}
+		if len(rules) != 0 {
+			return fmt.Errorf("coxedge_network_policy_rule %s still has %d rules", rs.Primary.ID, len(rules))
+		}
+	}
+	return nil
+}
+
+func testAccDeleteNetworkPolicyRule(t *testing.T, rs *terraform.ResourceState) {
+	err := testAccClient().DeleteNetworkPolicyRule(context.Background(), rs.Primary.Attributes["environment_name"], rs.Primary.ID, rs.Primary.Attributes["organization_id"],
+		apiclient.NetworkPolicyRuleCreateRequest{
+			NetworkPolicy: []apiclient.NetworkPolicyList{{Id: rs.Primary.Attributes["network_policy.0.id"]}},
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testAccNetworkPolicyRuleConfig(workloadName string, description string) string {
+	return testAccProviderConfig() + testAccWorkloadResource(workloadName, 1) + fmt.Sprintf(`
+resource "coxedge_network_policy_rule" "test" {
+  organization_id  = coxedge_workload.test.organization_id
+  environment_name = coxedge_workload.test.environment_name
+  network_policy {
+    workload_id = coxedge_workload.test.id
+    description = %q
+    protocol    = "TCP"
+    type        = "INBOUND"
+    action      = "ALLOW"
+    source      = "0.0.0.0/0"
+    port_range  = "80"
+  }
+}
+`, description)
+}
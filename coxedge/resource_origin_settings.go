@@ -25,27 +25,23 @@ func resourceOriginSettings() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
-		Schema: getOriginSettingsSchema(),
+		Schema:        getOriginSettingsSchema(),
+		CustomizeDiff: customizeDiffProviderDefaults("organization_id", "environment_name"),
 	}
 }
 
 func resourceOriginSettingsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	// Warning or errors can be collected in a slice type
-	var diags diag.Diagnostics
-
 	//Convert resource data to API object
 	updatedOriginSettings := convertResourceDataToOriginSettingsCreateAPIObject(d)
 	d.SetId(updatedOriginSettings.Id)
 
 	//Run Update since you do not "create" these
-	resourceOriginSettingsUpdate(ctx, d, m)
-
-	return diags
+	return resourceOriginSettingsUpdate(ctx, d, m)
 }
 
 func resourceOriginSettingsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	//Get the API Client
-	coxEdgeClient := m.(apiclient.Client)
+	coxEdgeClient := m.(apiclient.API)
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -64,7 +60,12 @@ func resourceOriginSettingsRead(ctx context.Context, d *schema.ResourceData, m i
 	organizationId := d.Get("organization_id").(string)
 
 	//Get the resource
-	originSettings, err := coxEdgeClient.GetOriginSettings(d.Get("environment_name").(string), resourceId, organizationId)
+	originSettings, err := coxEdgeClient.GetOriginSettings(ctx, d.Get("environment_name").(string), resourceId, organizationId)
+	if apiclient.IsNotFound(err) {
+		//Removed outside of Terraform, drop it from state so it is recreated
+		d.SetId("")
+		return diags
+	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -75,7 +76,7 @@ func resourceOriginSettingsRead(ctx context.Context, d *schema.ResourceData, m i
 
 func resourceOriginSettingsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	//Get the API Client
-	coxEdgeClient := m.(apiclient.Client)
+	coxEdgeClient := m.(apiclient.API)
 
 	//Get the resource Id
 	resourceId := d.Id()
@@ -84,7 +85,7 @@ func resourceOriginSettingsUpdate(ctx context.Context, d *schema.ResourceData, m
 	updatedOriginSettings := convertResourceDataToOriginSettingsCreateAPIObject(d)
 	organizationId := d.Get("organization_id").(string)
 	//Call the API
-	_, err := coxEdgeClient.UpdateOriginSettings(resourceId, updatedOriginSettings, organizationId)
+	_, err := coxEdgeClient.UpdateOriginSettings(ctx, resourceId, updatedOriginSettings, organizationId)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -99,20 +100,7 @@ func resourceOriginSettingsDelete(ctx context.Context, d *schema.ResourceData, m
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
-	//Get the API Client
-	coxEdgeClient := m.(apiclient.Client)
-
-	//Get the resource Id
-	resourceId := d.Id()
-
-	//Delete the OriginSettings
-	err := coxEdgeClient.DeleteOriginSettings(d.Get("environment_name").(string), resourceId)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	// From Docs: d.SetId("") is automatically called assuming delete returns no errors, but
-	// it is added here for explicitness.
+	//Origin settings live as long as their site, so destroying them only removes them from state
 	d.SetId("")
 
 	return diags
@@ -188,11 +176,16 @@ func convertResourceDataToOriginSettingsCreateAPIObject(d *schema.ResourceData)
 
 func convertOriginSettingsAPIObjectToResourceData(d *schema.ResourceData, originSettings *apiclient.OriginSettings) {
 	d.Set("id", originSettings.Id)
+	d.Set("site_id", originSettings.Id)
 	d.Set("stack_id", originSettings.StackId)
 	d.Set("scope_configuration_id", originSettings.ScopeConfigurationId)
 	d.Set("domain", originSettings.Domain)
-	d.Set("websockets_enabled", strconv.FormatBool(*originSettings.WebSocketsEnabled))
-	d.Set("ssl_validation_enabled", strconv.FormatBool(*originSettings.SSLValidationEnabled))
+	if originSettings.WebSocketsEnabled != nil {
+		d.Set("websockets_enabled", strconv.FormatBool(*originSettings.WebSocketsEnabled))
+	}
+	if originSettings.SSLValidationEnabled != nil {
+		d.Set("ssl_validation_enabled", strconv.FormatBool(*originSettings.SSLValidationEnabled))
+	}
 	d.Set("pull_protocol", originSettings.PullProtocol)
 	d.Set("host_header", originSettings.HostHeader)
 	origin := make([]map[string]string, 1)
@@ -204,6 +197,8 @@ func convertOriginSettingsAPIObjectToResourceData(d *schema.ResourceData, origin
 	origin[0]["password"] = originSettings.Origin.Password
 	origin[0]["common_certificate_name"] = originSettings.Origin.CommonCertificateName
 	d.Set("origin", origin)
-	d.Set("backup_origin_enabled", strconv.FormatBool(*originSettings.BackupOriginEnabled))
+	if originSettings.BackupOriginEnabled != nil {
+		d.Set("backup_origin_enabled", strconv.FormatBool(*originSettings.BackupOriginEnabled))
+	}
 	d.Set("backup_origin_exclude_codes", originSettings.BackupOriginExcludeCodes)
 }
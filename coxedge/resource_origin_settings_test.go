@@ -0,0 +1,95 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+package coxedge
+
+import (
+	"context"
+	"coxedge/terraform-provider/coxedge/apiclient"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"testing"
+)
+
+func TestAccOriginSettings(t *testing.T) {
+	siteDomain := testAccName("tf-acc-site") + ".example.com"
+	resourceName := "coxedge_origin_setting.test"
+	var rs *terraform.ResourceState
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		//Settings live as long as their site, destroying them only drops them from state
+		CheckDestroy: testAccCheckDestroyed("coxedge_site", testAccSiteExists),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOriginSettingsConfig(siteDomain, "MATCH", "origin.example.com:80"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "site_id", "coxedge_site.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "pull_protocol", "MATCH"),
+					resource.TestCheckResourceAttr(resourceName, "websockets_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "origin.0.address", "origin.example.com:80"),
+				),
+			},
+			{
+				Config: testAccOriginSettingsConfig(siteDomain, "HTTPS", "origin.example.com:443"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "pull_protocol", "HTTPS"),
+					resource.TestCheckResourceAttr(resourceName, "origin.0.address", "origin.example.com:443"),
+					testAccStoreResourceState(resourceName, &rs),
+				),
+			},
+			{
+				Config:   testAccOriginSettingsConfig(siteDomain, "HTTPS", "origin.example.com:443"),
+				PlanOnly: true,
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateIdFunc:       testAccCompositeImportId(resourceName, false),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			{
+				//Settings changed outside of Terraform are planned to be changed back
+				PreConfig: func() {
+					testAccChangeOriginSettingsPullProtocol(t, rs)
+				},
+				Config:             testAccOriginSettingsConfig(siteDomain, "HTTPS", "origin.example.com:443"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccChangeOriginSettingsPullProtocol(t *testing.T, rs *terraform.ResourceState) {
+	settings := apiclient.OriginSettings{EnvironmentName: rs.Primary.Attributes["environment_name"], PullProtocol: "HTTP"}
+	_, err := testAccClient().UpdateOriginSettings(context.Background(), rs.Primary.ID, settings, rs.Primary.Attributes["organization_id"])
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testAccOriginSettingsConfig(siteDomain string, pullProtocol string, address string) string {
+	return testAccProviderConfig() + testAccSiteResource(siteDomain, "") + fmt.Sprintf(`
+resource "coxedge_origin_setting" "test" {
+  organization_id        = coxedge_site.test.organization_id
+  environment_name       = coxedge_site.test.environment_name
+  site_id                = coxedge_site.test.id
+  domain                 = coxedge_site.test.domain
+  websockets_enabled     = "false"
+  ssl_validation_enabled = "false"
+  backup_origin_enabled  = "false"
+  pull_protocol          = %q
+  host_header            = "Host: origin.example.com"
+
+  origin {
+    address = %q
+  }
+}
+`, pullProtocol, address)
+}
@@ -0,0 +1,149 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+package coxedge
+
+import (
+	"context"
+	"coxedge/terraform-provider/coxedge/apiclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"time"
+)
+
+func resourceRole() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceRoleCreate,
+		ReadContext:   resourceRoleRead,
+		UpdateContext: resourceRoleUpdate,
+		DeleteContext: resourceRoleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema:        getRoleSchema(),
+		CustomizeDiff: customizeDiffProviderDefaults("organization_id"),
+	}
+}
+
+func resourceRoleCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	//Get the API Client
+	coxEdgeClient := m.(apiclient.API)
+
+	//Convert resource data to API Object
+	newRole := convertResourceDataToRoleCreateAPIObject(d)
+
+	//Call the API
+	createdRole, err := coxEdgeClient.CreateRole(ctx, newRole)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	//Save the Id
+	d.SetId(createdRole.Id)
+
+	return resourceRoleRead(ctx, d, m)
+}
+
+func resourceRoleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	//Get the API Client
+	coxEdgeClient := m.(apiclient.API)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	//Get the resource Id
+	resourceId := d.Id()
+
+	//Get the resource
+	role, err := coxEdgeClient.GetRole(ctx, resourceId)
+	if apiclient.IsNotFound(err) {
+		//Removed outside of Terraform, drop it from state so it is recreated
+		d.SetId("")
+		return diags
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	//Update state
+	convertRoleAPIObjectToResourceData(d, role)
+
+	return diags
+}
+
+func resourceRoleUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	//Get the API Client
+	coxEdgeClient := m.(apiclient.API)
+
+	//Get the resource Id
+	resourceId := d.Id()
+
+	//Convert resource data to API object
+	updatedRole := convertResourceDataToRoleCreateAPIObject(d)
+
+	//Call the API
+	_, err := coxEdgeClient.UpdateRole(ctx, resourceId, updatedRole)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	//Set last_updated
+	d.Set("last_updated", time.Now().Format(time.RFC850))
+
+	return resourceRoleRead(ctx, d, m)
+}
+
+func resourceRoleDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	//Get the API Client
+	coxEdgeClient := m.(apiclient.API)
+
+	//Get the resource Id
+	resourceId := d.Id()
+
+	//Delete the Role
+	err := coxEdgeClient.DeleteRole(ctx, resourceId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// From Docs: d.SetId("") is automatically called assuming delete returns no errors, but
+	// it is added here for explicitness.
+	d.SetId("")
+
+	return diags
+}
+
+func convertResourceDataToRoleCreateAPIObject(d *schema.ResourceData) apiclient.RoleCreateRequest {
+	//Create update role struct
+	updatedRole := apiclient.RoleCreateRequest{
+		Name:         d.Get("name").(string),
+		Description:  d.Get("description").(string),
+		DefaultScope: d.Get("default_scope").(string),
+		OrganizationId: apiclient.IdOnlyHelper{
+			Id: d.Get("organization_id").(string),
+		},
+		//Always sent, so removing every permission clears them
+		Permissions: []string{},
+	}
+
+	for _, val := range d.Get("permissions").(*schema.Set).List() {
+		updatedRole.Permissions = append(updatedRole.Permissions, val.(string))
+	}
+
+	return updatedRole
+}
+
+func convertRoleAPIObjectToResourceData(d *schema.ResourceData, role *apiclient.Roles) {
+	//Store the data
+	d.Set("name", role.Name)
+	d.Set("description", role.Description)
+	d.Set("organization_id", role.Organization.Id)
+	d.Set("default_scope", role.DefaultScope)
+	d.Set("permissions", role.Permissions)
+	d.Set("is_system", role.IsSystem)
+}
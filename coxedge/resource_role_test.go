@@ -0,0 +1,117 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+package coxedge
+
+import (
+	"context"
+	"coxedge/terraform-provider/coxedge/apiclient"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"strings"
+	"testing"
+)
+
+func TestAccRole(t *testing.T) {
+	name := testAccName("tf-acc-role")
+	userName := testAccName("tf-acc-user")
+	resourceName := "coxedge_role.test"
+	var rs *terraform.ResourceState
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckDestroyed("coxedge_role", testAccRoleExists),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoleConfig(name, userName, "Terraform acceptance test", []string{"environments:view"}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "default_scope", "ENVIRONMENT"),
+					resource.TestCheckResourceAttr(resourceName, "organization_id", testAccOrganizationId()),
+					resource.TestCheckResourceAttr(resourceName, "permissions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "is_system", "false"),
+					//Users are given the Terraform managed role
+					resource.TestCheckResourceAttrPair("coxedge_user.test", "roles.0.id", resourceName, "id"),
+				),
+			},
+			{
+				Config: testAccRoleConfig(name, userName, "Terraform acceptance test, updated", []string{"environments:view", "environments:manage"}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "description", "Terraform acceptance test, updated"),
+					resource.TestCheckResourceAttr(resourceName, "permissions.#", "2"),
+					testAccStoreResourceState(resourceName, &rs),
+				),
+			},
+			{
+				Config:   testAccRoleConfig(name, userName, "Terraform acceptance test, updated", []string{"environments:view", "environments:manage"}),
+				PlanOnly: true,
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			{
+				//A role changed outside of Terraform is planned to be changed back
+				PreConfig: func() {
+					testAccChangeRoleDescription(t, rs)
+				},
+				Config:             testAccRoleConfig(name, userName, "Terraform acceptance test, updated", []string{"environments:view", "environments:manage"}),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccRoleExists(ctx context.Context, client *apiclient.Client, rs *terraform.ResourceState) error {
+	_, err := client.GetRole(ctx, rs.Primary.ID)
+	return err
+}
+
+func testAccChangeRoleDescription(t *testing.T, rs *terraform.ResourceState) {
+	ctx := context.Background()
+	client := testAccClient()
+	role, err := client.GetRole(ctx, rs.Primary.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.UpdateRole(ctx, role.Id, apiclient.RoleCreateRequest{
+		Name:           role.Name,
+		Description:    "Changed outside of Terraform",
+		DefaultScope:   role.DefaultScope,
+		Permissions:    role.Permissions,
+		OrganizationId: apiclient.IdOnlyHelper{Id: role.Organization.Id},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testAccRoleConfig(name string, userName string, description string, permissions []string) string {
+	return testAccProviderConfig() + fmt.Sprintf(`
+resource "coxedge_role" "test" {
+  name            = %[1]q
+  description     = %[2]q
+  organization_id = %[3]q
+  default_scope   = "ENVIRONMENT"
+  permissions     = ["%[4]s"]
+}
+
+resource "coxedge_user" "test" {
+  user_name       = %[5]q
+  first_name      = "Acceptance"
+  last_name       = "Test"
+  email           = "%[5]s@example.com"
+  organization_id = %[3]q
+  roles {
+    id = coxedge_role.test.id
+  }
+}
+`, name, description, testAccOrganizationId(), strings.Join(permissions, `", "`), userName)
+}
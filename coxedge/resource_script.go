@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"strings"
 	"time"
 )
 
@@ -23,13 +24,19 @@ func resourceScript() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
-		Schema: getScriptSchema(),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema:        getScriptSchema(),
+		CustomizeDiff: customizeDiffProviderDefaults("organization_id", "environment_name"),
 	}
 }
 
 func resourceScriptCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	///Get the API Client
-	coxEdgeClient := m.(apiclient.Client)
+	coxEdgeClient := m.(apiclient.API)
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -38,7 +45,7 @@ func resourceScriptCreate(ctx context.Context, d *schema.ResourceData, m interfa
 	newScript := convertResourceDataToScriptCreateAPIObject(d)
 
 	//Call the API
-	createdScript, err := coxEdgeClient.CreateScript(
+	createdScript, err := coxEdgeClient.CreateScript(ctx,
 		d.Get("site_id").(string),
 		d.Get("environment_name").(string),
 		newScript,
@@ -49,33 +56,52 @@ func resourceScriptCreate(ctx context.Context, d *schema.ResourceData, m interfa
 
 	tflog.Info(ctx, "Initiated Create. Awaiting task result.")
 
-	//Await
-	taskResult, err := coxEdgeClient.AwaitTaskResolveWithDefaults(ctx, createdScript.TaskId)
-	if err != nil {
-		return diag.FromErr(err)
+	//Await, recording the task in state so an interrupted apply can resume it
+	if diags = awaitCreateTask(ctx, d, coxEdgeClient, createdScript.TaskId, "coxedge_script", d.Get("name").(string)); diags != nil {
+		return diags
 	}
 
-	//Save the Id
-	d.SetId(taskResult.Data.Result.Id)
-
-	return diags
+	return resourceScriptRead(ctx, d, m)
 }
 
 func resourceScriptRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	//Get the API Client
-	coxEdgeClient := m.(apiclient.Client)
+	coxEdgeClient := m.(apiclient.API)
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
+	//check the id comes with id, site_id, environment_name & organization_id, then split the value -> in case of importing the resource
+	//format is <script_id>:<site_id>:<environment_name>:<organization_id>
+	if strings.Contains(d.Id(), ":") {
+		keys := strings.Split(d.Id(), ":")
+		if len(keys) != 4 {
+			return diag.Errorf("unexpected import ID %q, expected <script_id>:<site_id>:<environment_name>:<organization_id>", d.Id())
+		}
+		d.SetId(keys[0])
+		d.Set("site_id", keys[1])
+		d.Set("environment_name", keys[2])
+		d.Set("organization_id", keys[3])
+	}
+
+	//Resume a create that was interrupted while awaiting its task
+	if diags = resumePendingTask(ctx, d, coxEdgeClient, "coxedge_script", d.Get("name").(string)); diags.HasError() || d.Id() == "" {
+		return diags
+	}
+
 	//Get the resource Id
 	resourceId := d.Id()
 
 	//Get the resource
-	script, err := coxEdgeClient.GetScript(resourceId,
+	script, err := coxEdgeClient.GetScript(ctx, resourceId,
 		d.Get("site_id").(string),
 		d.Get("environment_name").(string),
 		d.Get("organization_id").(string))
+	if apiclient.IsNotFound(err) {
+		//Removed outside of Terraform, drop it from state so it is recreated
+		d.SetId("")
+		return diags
+	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -87,7 +113,7 @@ func resourceScriptRead(ctx context.Context, d *schema.ResourceData, m interface
 
 func resourceScriptUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	//Get the API Client
-	coxEdgeClient := m.(apiclient.Client)
+	coxEdgeClient := m.(apiclient.API)
 
 	//Get the resource Id
 	resourceId := d.Id()
@@ -96,7 +122,7 @@ func resourceScriptUpdate(ctx context.Context, d *schema.ResourceData, m interfa
 	updatedScript := convertResourceDataToScriptCreateAPIObject(d)
 
 	//Call the API
-	updateScriptResponse, err := coxEdgeClient.UpdateScript(resourceId,
+	updateScriptResponse, err := coxEdgeClient.UpdateScript(ctx, resourceId,
 		d.Get("site_id").(string),
 		d.Get("environment_name").(string),
 		updatedScript,
@@ -105,9 +131,9 @@ func resourceScriptUpdate(ctx context.Context, d *schema.ResourceData, m interfa
 		return diag.FromErr(err)
 	}
 
-	_, err = coxEdgeClient.AwaitTaskResolveWithDefaults(ctx, updateScriptResponse.TaskId)
+	_, err = coxEdgeClient.AwaitTaskResolveWithTimeout(ctx, updateScriptResponse.TaskId, d.Timeout(schema.TimeoutUpdate))
 	if err != nil {
-		return diag.FromErr(err)
+		return taskDiagnostics(err, "update", "coxedge_script", d.Get("name").(string))
 	}
 
 	//Set last_updated
@@ -121,13 +147,18 @@ func resourceScriptDelete(ctx context.Context, d *schema.ResourceData, m interfa
 	var diags diag.Diagnostics
 
 	//Get the API Client
-	coxEdgeClient := m.(apiclient.Client)
+	coxEdgeClient := m.(apiclient.API)
+
+	//Resolve an interrupted create first, so the created object is deleted rather than the task
+	if diags = resumePendingTask(ctx, d, coxEdgeClient, "coxedge_script", d.Get("name").(string)); diags.HasError() || d.Id() == "" {
+		return diags
+	}
 
 	//Get the resource Id
 	resourceId := d.Id()
 
 	//Delete the Script
-	err := coxEdgeClient.DeleteScript(resourceId,
+	err := coxEdgeClient.DeleteScript(ctx, resourceId,
 		d.Get("site_id").(string),
 		d.Get("environment_name").(string),
 		d.Get("organization_id").(string))
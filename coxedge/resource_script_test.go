@@ -0,0 +1,92 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+package coxedge
+
+import (
+	"context"
+	"coxedge/terraform-provider/coxedge/apiclient"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"testing"
+)
+
+func TestAccScript(t *testing.T) {
+	siteDomain := testAccName("tf-acc-site") + ".example.com"
+	name := testAccName("tf-acc-script")
+	resourceName := "coxedge_script.test"
+	var rs *terraform.ResourceState
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckDestroyed("coxedge_script", testAccScriptExists),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScriptConfig(siteDomain, name, "v1/api"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "routes.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "routes.0", "v1/api"),
+					resource.TestCheckResourceAttrPair(resourceName, "site_id", "coxedge_site.test", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "version"),
+				),
+			},
+			{
+				Config: testAccScriptConfig(siteDomain, name, "v2/api"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "routes.0", "v2/api"),
+					testAccStoreResourceState(resourceName, &rs),
+				),
+			},
+			{
+				Config:   testAccScriptConfig(siteDomain, name, "v2/api"),
+				PlanOnly: true,
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateIdFunc:       testAccCompositeImportId(resourceName, true),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated", "pending_task_id"},
+			},
+			{
+				//A script deleted outside of Terraform is planned to be created again
+				PreConfig: func() {
+					testAccDeleteScript(t, rs)
+				},
+				Config:             testAccScriptConfig(siteDomain, name, "v2/api"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccScriptExists(ctx context.Context, client *apiclient.Client, rs *terraform.ResourceState) error {
+	_, err := client.GetScript(ctx, rs.Primary.ID, rs.Primary.Attributes["site_id"], rs.Primary.Attributes["environment_name"], rs.Primary.Attributes["organization_id"])
+	return err
+}
+
+func testAccDeleteScript(t *testing.T, rs *terraform.ResourceState) {
+	err := testAccClient().DeleteScript(context.Background(), rs.Primary.ID, rs.Primary.Attributes["site_id"], rs.Primary.Attributes["environment_name"], rs.Primary.Attributes["organization_id"])
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testAccScriptConfig(siteDomain string, name string, route string) string {
+	return testAccProviderConfig() + testAccSiteResource(siteDomain, "") + fmt.Sprintf(`
+resource "coxedge_script" "test" {
+  organization_id  = coxedge_site.test.organization_id
+  environment_name = coxedge_site.test.environment_name
+  site_id          = coxedge_site.test.id
+  name             = %q
+  routes           = [%q]
+  code             = "addEventListener('fetch', event => event.respondWith(fetch(event.request)))"
+}
+`, name, route)
+}
@@ -10,6 +10,7 @@ import (
 	"coxedge/terraform-provider/coxedge/apiclient"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"strings"
 	"time"
 )
 
@@ -22,13 +23,19 @@ func resourceSite() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
-		Schema: getSiteSchema(),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema:        getSiteSchema(),
+		CustomizeDiff: customizeDiffProviderDefaults("organization_id", "environment_name"),
 	}
 }
 
 func resourceSiteCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	//Get the API Client
-	coxEdgeClient := m.(apiclient.Client)
+	coxEdgeClient := m.(apiclient.API)
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -37,35 +44,53 @@ func resourceSiteCreate(ctx context.Context, d *schema.ResourceData, m interface
 
 	organizationId := d.Get("organization_id").(string)
 	//Call the API
-	createdSite, err := coxEdgeClient.CreateSite(newSite, organizationId)
+	createdSite, err := coxEdgeClient.CreateSite(ctx, newSite, organizationId)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	//Await
-	taskResult, err := coxEdgeClient.AwaitTaskResolveWithDefaults(ctx, createdSite.TaskId)
-	if err != nil {
-		return diag.FromErr(err)
+	//Await, recording the task in state so an interrupted apply can resume it
+	if diags = awaitCreateTask(ctx, d, coxEdgeClient, createdSite.TaskId, "coxedge_site", d.Get("domain").(string)); diags != nil {
+		return diags
 	}
 
-	//Save the Id
-	d.SetId(taskResult.Data.Result.Id)
-
-	return diags
+	return resourceSiteRead(ctx, d, m)
 }
 
 func resourceSiteRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	//Get the API Client
-	coxEdgeClient := m.(apiclient.Client)
+	coxEdgeClient := m.(apiclient.API)
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
+	//check the id comes with id, environment_name & organization_id, then split the value -> in case of importing the resource
+	//format is <site_id>:<environment_name>:<organization_id>
+	if strings.Contains(d.Id(), ":") {
+		keys := strings.Split(d.Id(), ":")
+		if len(keys) != 3 {
+			return diag.Errorf("unexpected import ID %q, expected <site_id>:<environment_name>:<organization_id>", d.Id())
+		}
+		d.SetId(keys[0])
+		d.Set("environment_name", keys[1])
+		d.Set("organization_id", keys[2])
+	}
+
+	//Resume a create that was interrupted while awaiting its task
+	if diags = resumePendingTask(ctx, d, coxEdgeClient, "coxedge_site", d.Get("domain").(string)); diags.HasError() || d.Id() == "" {
+		return diags
+	}
+
 	//Get the resource Id
 	resourceId := d.Id()
 	organizationId := d.Get("organization_id").(string)
 	//Get the resource
-	site, err := coxEdgeClient.GetSite(d.Get("environment_name").(string), resourceId, organizationId)
+	site, err := coxEdgeClient.GetSite(ctx, d.Get("environment_name").(string), resourceId, organizationId)
+	if apiclient.IsNotFound(err) {
+		//Removed outside of Terraform, drop it from state so it is recreated
+		d.SetId("")
+		return diags
+	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -78,7 +103,7 @@ func resourceSiteRead(ctx context.Context, d *schema.ResourceData, m interface{}
 
 func resourceSiteUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	//Get the API Client
-	coxEdgeClient := m.(apiclient.Client)
+	coxEdgeClient := m.(apiclient.API)
 
 	//Get the resource Id
 	resourceId := d.Id()
@@ -86,10 +111,15 @@ func resourceSiteUpdate(ctx context.Context, d *schema.ResourceData, m interface
 	value, hasValue := d.GetOk("operation")
 	if hasValue {
 		//Call the API
-		_, err := coxEdgeClient.UpdateSite(resourceId, d.Get("environment_name").(string), value.(string), organizationId)
+		taskResp, err := coxEdgeClient.UpdateSite(ctx, resourceId, d.Get("environment_name").(string), value.(string), organizationId)
 		if err != nil {
 			return diag.FromErr(err)
 		}
+		//Await
+		_, err = coxEdgeClient.AwaitTaskResolveWithTimeout(ctx, taskResp.TaskId, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return taskDiagnostics(err, "update", "coxedge_site", d.Get("domain").(string))
+		}
 		//Set last_updated
 		d.Set("last_updated", time.Now().Format(time.RFC850))
 		return resourceSiteRead(ctx, d, m)
@@ -109,13 +139,18 @@ func resourceSiteDelete(ctx context.Context, d *schema.ResourceData, m interface
 	var diags diag.Diagnostics
 
 	//Get the API Client
-	coxEdgeClient := m.(apiclient.Client)
+	coxEdgeClient := m.(apiclient.API)
+
+	//Resolve an interrupted create first, so the created object is deleted rather than the task
+	if diags = resumePendingTask(ctx, d, coxEdgeClient, "coxedge_site", d.Get("domain").(string)); diags.HasError() || d.Id() == "" {
+		return diags
+	}
 
 	//Get the resource Id
 	resourceId := d.Id()
 	organizationId := d.Get("organization_id").(string)
 	//Delete the Site
-	err := coxEdgeClient.DeleteSite(d.Get("environment_name").(string), resourceId, organizationId)
+	err := coxEdgeClient.DeleteSite(ctx, d.Get("environment_name").(string), resourceId, organizationId)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -0,0 +1,168 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+package coxedge
+
+import (
+	"context"
+	"coxedge/terraform-provider/coxedge/apiclient"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"testing"
+)
+
+//testAccSiteImportIgnore Site attributes the API does not return, or that only exist in state
+var testAccSiteImportIgnore = []string{"hostname", "protocol", "auth_method", "username", "password", "operation", "last_updated", "pending_task_id"}
+
+func TestAccSite(t *testing.T) {
+	domain := testAccName("tf-acc-site") + ".example.com"
+	resourceName := "coxedge_site.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckDestroyed("coxedge_site", testAccSiteExists),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSiteConfig(domain, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "domain", domain),
+					resource.TestCheckResourceAttr(resourceName, "services.#", "3"),
+					resource.TestCheckResourceAttrSet(resourceName, "stack_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "edge_address"),
+					resource.TestCheckResourceAttr(resourceName, "pending_task_id", ""),
+				),
+			},
+			{
+				Config: testAccSiteConfig(domain, "disable_waf"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "operation", "disable_waf"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_updated"),
+				),
+			},
+			{
+				Config:   testAccSiteConfig(domain, "disable_waf"),
+				PlanOnly: true,
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateIdFunc:       testAccCompositeImportId(resourceName, false),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: testAccSiteImportIgnore,
+			},
+			{
+				//A site deleted outside of Terraform is planned to be created again
+				PreConfig: func() {
+					testAccDeleteSite(t, domain)
+				},
+				Config:             testAccSiteConfig(domain, "disable_waf"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+//The organization and environment set on the provider block are used, and stored in state, when the site leaves
+//them unset
+func TestAccSiteProviderDefaults(t *testing.T) {
+	domain := testAccName("tf-acc-site") + ".example.com"
+	resourceName := "coxedge_site.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckDestroyed("coxedge_site", testAccSiteExists),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSiteProviderDefaultsConfig(domain),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "organization_id", testAccOrganizationId()),
+					resource.TestCheckResourceAttr(resourceName, "environment_name", testAccEnvironmentName()),
+				),
+			},
+			{
+				Config:   testAccSiteProviderDefaultsConfig(domain),
+				PlanOnly: true,
+			},
+			{
+				//Setting the same values on the resource is not a change
+				Config:   testAccSiteConfig(domain, ""),
+				PlanOnly: true,
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateIdFunc:       testAccCompositeImportId(resourceName, false),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: testAccSiteImportIgnore,
+			},
+		},
+	})
+}
+
+func testAccSiteExists(ctx context.Context, client *apiclient.Client, rs *terraform.ResourceState) error {
+	_, err := client.GetSite(ctx, rs.Primary.Attributes["environment_name"], rs.Primary.ID, rs.Primary.Attributes["organization_id"])
+	return err
+}
+
+func testAccDeleteSite(t *testing.T, domain string) {
+	ctx := context.Background()
+	client := testAccClient()
+	sites, err := client.GetSites(ctx, testAccEnvironmentName(), testAccOrganizationId())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, site := range sites {
+		if site.Domain == domain {
+			if err = client.DeleteSite(ctx, testAccEnvironmentName(), site.Id, testAccOrganizationId()); err != nil {
+				t.Fatal(err)
+			}
+			return
+		}
+	}
+	t.Fatalf("site %s not found", domain)
+}
+
+//testAccSiteResource Site other acceptance tests attach their resources to
+func testAccSiteResource(domain string, operation string) string {
+	operationArgument := ""
+	if operation != "" {
+		operationArgument = fmt.Sprintf("operation        = %q", operation)
+	}
+	return fmt.Sprintf(`
+resource "coxedge_site" "test" {
+  organization_id  = %q
+  environment_name = %q
+  domain           = %q
+  hostname         = "192.0.2.10"
+  protocol         = "HTTPS"
+  services         = ["CDN", "SERVERLESS_EDGE_ENGINE", "WAF"]
+  %s
+}
+`, testAccOrganizationId(), testAccEnvironmentName(), domain, operationArgument)
+}
+
+func testAccSiteConfig(domain string, operation string) string {
+	return testAccProviderConfig() + testAccSiteResource(domain, operation)
+}
+
+func testAccSiteProviderDefaultsConfig(domain string) string {
+	return fmt.Sprintf(`
+provider "coxedge" {
+  organization_id  = %q
+  environment_name = %q
+}
+
+resource "coxedge_site" "test" {
+  domain   = %q
+  hostname = "192.0.2.10"
+  protocol = "HTTPS"
+  services = ["CDN", "SERVERLESS_EDGE_ENGINE", "WAF"]
+}
+`, testAccOrganizationId(), testAccEnvironmentName(), domain)
+}
@@ -22,13 +22,14 @@ func resourceUser() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
-		Schema: getUserSchema(),
+		Schema:        getUserSchema(),
+		CustomizeDiff: customizeDiffProviderDefaults("organization_id"),
 	}
 }
 
 func resourceUserCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	//Get the API Client
-	coxEdgeClient := m.(apiclient.Client)
+	coxEdgeClient := m.(apiclient.API)
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -37,7 +38,7 @@ func resourceUserCreate(ctx context.Context, d *schema.ResourceData, m interface
 	newUser := convertResourceDataToUserCreateAPIObject(d)
 
 	//Call the API
-	createdUser, err := coxEdgeClient.CreateUser(newUser)
+	createdUser, err := coxEdgeClient.CreateUser(ctx, newUser)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -50,7 +51,7 @@ func resourceUserCreate(ctx context.Context, d *schema.ResourceData, m interface
 
 func resourceUserRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	//Get the API Client
-	coxEdgeClient := m.(apiclient.Client)
+	coxEdgeClient := m.(apiclient.API)
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -59,7 +60,12 @@ func resourceUserRead(ctx context.Context, d *schema.ResourceData, m interface{}
 	resourceId := d.Id()
 
 	//Get the resource
-	user, err := coxEdgeClient.GetUser(resourceId)
+	user, err := coxEdgeClient.GetUser(ctx, resourceId)
+	if apiclient.IsNotFound(err) {
+		//Removed outside of Terraform, drop it from state so it is recreated
+		d.SetId("")
+		return diags
+	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -72,7 +78,7 @@ func resourceUserRead(ctx context.Context, d *schema.ResourceData, m interface{}
 
 func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	//Get the API Client
-	coxEdgeClient := m.(apiclient.Client)
+	coxEdgeClient := m.(apiclient.API)
 
 	//Get the resource Id
 	resourceId := d.Id()
@@ -81,7 +87,7 @@ func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, m interface
 	updatedUser := convertResourceDataToUserCreateAPIObject(d)
 
 	//Call the API
-	_, err := coxEdgeClient.UpdateUser(resourceId, updatedUser)
+	_, err := coxEdgeClient.UpdateUser(ctx, resourceId, updatedUser)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -97,13 +103,13 @@ func resourceUserDelete(ctx context.Context, d *schema.ResourceData, m interface
 	var diags diag.Diagnostics
 
 	//Get the API Client
-	coxEdgeClient := m.(apiclient.Client)
+	coxEdgeClient := m.(apiclient.API)
 
 	//Get the resource Id
 	resourceId := d.Id()
 
 	//Delete the User
-	err := coxEdgeClient.DeleteUser(resourceId)
+	err := coxEdgeClient.DeleteUser(ctx, resourceId)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -143,11 +149,11 @@ func convertResourceDataToUserCreateAPIObject(d *schema.ResourceData) apiclient.
 
 func convertUserAPIObjectToResourceData(d *schema.ResourceData, user *apiclient.User) {
 	//Store the data
-	d.Set("id", user.Id)
 	d.Set("organization_id", user.Organization.Id)
 	d.Set("user_name", user.UserName)
 	d.Set("first_name", user.FirstName)
 	d.Set("last_name", user.LastName)
+	d.Set("email", user.Email)
 
 	roles := make([]interface{}, len(user.Roles), len(user.Roles))
 	for i, role := range user.Roles {
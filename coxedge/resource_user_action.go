@@ -0,0 +1,94 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+package coxedge
+
+import (
+	"context"
+	"coxedge/terraform-provider/coxedge/apiclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"time"
+)
+
+func resourceUserAction() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceUserActionCreate,
+		ReadContext:   resourceUserActionRead,
+		DeleteContext: resourceUserActionDelete,
+		Schema:        getUserActionSchema(),
+	}
+}
+
+//userAction The client call running an action on a user
+func userAction(coxEdgeClient apiclient.API, action string) func(ctx context.Context, id string) error {
+	switch action {
+	case "unlock":
+		return coxEdgeClient.UnlockUser
+	}
+	return nil
+}
+
+func resourceUserActionCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	//Get the API Client
+	coxEdgeClient := m.(apiclient.API)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	userId := d.Get("user_id").(string)
+	action := d.Get("action").(string)
+
+	//Call the API
+	run := userAction(coxEdgeClient, action)
+	if run == nil {
+		return diag.Errorf("unknown user action %q", action)
+	}
+	err := run(ctx, userId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	//Record the result
+	user, err := coxEdgeClient.GetUser(ctx, userId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(userId + ":" + action)
+	d.Set("user_status", user.Status)
+	d.Set("performed_at", time.Now().Format(time.RFC850))
+
+	return diags
+}
+
+func resourceUserActionRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	//Get the API Client
+	coxEdgeClient := m.(apiclient.API)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	//The action already ran, only a user removed outside of Terraform is of interest
+	_, err := coxEdgeClient.GetUser(ctx, d.Get("user_id").(string))
+	if apiclient.IsNotFound(err) {
+		d.SetId("")
+		return diags
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceUserActionDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	//Actions cannot be undone, destroying one only removes it from state
+	d.SetId("")
+
+	return diags
+}
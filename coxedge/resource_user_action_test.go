@@ -0,0 +1,116 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+package coxedge
+
+import (
+	"context"
+	"coxedge/terraform-provider/coxedge/apiclient"
+	"coxedge/terraform-provider/coxedge/apiclient/mock"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"testing"
+)
+
+func TestAccUserAction(t *testing.T) {
+	userName := testAccName("tf-acc-user")
+	roleId := testAccRoleId(t)
+	resourceName := "coxedge_user_action.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckDestroyed("coxedge_user", testAccUserExists),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserActionConfig(userName, roleId, "unlock", "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "user_id", "coxedge_user.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "user_status", "ACTIVE"),
+					resource.TestCheckResourceAttrSet(resourceName, "performed_at"),
+				),
+			},
+			{
+				//Nothing runs again without a change
+				Config:   testAccUserActionConfig(userName, roleId, "unlock", "1"),
+				PlanOnly: true,
+			},
+			{
+				//A new trigger runs the action again
+				Config: testAccUserActionConfig(userName, roleId, "unlock", "2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "performed_at"),
+					resource.TestCheckResourceAttr(resourceName, "triggers.run", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccUserActionConfig(userName string, roleId string, action string, run string) string {
+	return testAccUserConfig(userName, "Acceptance", roleId) + fmt.Sprintf(`
+resource "coxedge_user_action" "test" {
+  user_id = coxedge_user.test.id
+  action  = %q
+  triggers = {
+    run = %q
+  }
+}
+`, action, run)
+}
+
+func TestUserActionCreate(t *testing.T) {
+	testCases := []struct {
+		name          string
+		action        string
+		err           error
+		expectUnlocks int
+		expectError   bool
+	}{
+		{name: "unlock", action: "unlock", expectUnlocks: 1},
+		{name: "failed unlock", action: "unlock", err: errors.New("user is not locked"), expectUnlocks: 1, expectError: true},
+		{name: "unknown action", action: "reset_password", expectError: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			client := &mock.APIMock{
+				UnlockUserFunc: func(ctx context.Context, id string) error {
+					return tc.err
+				},
+				GetUserFunc: func(ctx context.Context, id string) (*apiclient.User, error) {
+					return &apiclient.User{Id: id, Status: "ACTIVE"}, nil
+				},
+			}
+			d := schema.TestResourceDataRaw(t, getUserActionSchema(), map[string]interface{}{
+				"user_id": "user-1",
+				"action":  tc.action,
+			})
+
+			diags := resourceUserActionCreate(context.Background(), d, client)
+			if diags.HasError() != tc.expectError {
+				t.Fatalf("unexpected diagnostics %v", diags)
+			}
+			unlocks := client.UnlockUserCalls()
+			if len(unlocks) != tc.expectUnlocks {
+				t.Fatalf("expected %d unlock calls, got %d", tc.expectUnlocks, len(unlocks))
+			}
+			if len(unlocks) > 0 && unlocks[0].ID != "user-1" {
+				t.Errorf("unexpected user id %q", unlocks[0].ID)
+			}
+			if tc.expectError {
+				if d.Id() != "" {
+					t.Errorf("expected no state for a failed action, got id %q", d.Id())
+				}
+				return
+			}
+			if d.Id() != "user-1:"+tc.action || d.Get("user_status").(string) != "ACTIVE" || d.Get("performed_at").(string) == "" {
+				t.Errorf("unexpected state id %q, user_status %q, performed_at %q", d.Id(), d.Get("user_status"), d.Get("performed_at"))
+			}
+		})
+	}
+}
@@ -0,0 +1,108 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+package coxedge
+
+import (
+	"context"
+	"coxedge/terraform-provider/coxedge/apiclient"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"testing"
+)
+
+func TestAccUser(t *testing.T) {
+	userName := testAccName("tf-acc-user")
+	roleId := testAccRoleId(t)
+	resourceName := "coxedge_user.test"
+	var rs *terraform.ResourceState
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckDestroyed("coxedge_user", testAccUserExists),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserConfig(userName, "Acceptance", roleId),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "user_name", userName),
+					resource.TestCheckResourceAttr(resourceName, "first_name", "Acceptance"),
+					resource.TestCheckResourceAttr(resourceName, "email", userName+"@example.com"),
+					resource.TestCheckResourceAttr(resourceName, "roles.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "roles.0.id", roleId),
+				),
+			},
+			{
+				Config: testAccUserConfig(userName, "Updated", roleId),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "first_name", "Updated"),
+					testAccStoreResourceState(resourceName, &rs),
+				),
+			},
+			{
+				Config:   testAccUserConfig(userName, "Updated", roleId),
+				PlanOnly: true,
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			{
+				//A user changed outside of Terraform is planned to be changed back
+				PreConfig: func() {
+					testAccChangeUserFirstName(t, rs)
+				},
+				Config:             testAccUserConfig(userName, "Updated", roleId),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccUserExists(ctx context.Context, client *apiclient.Client, rs *terraform.ResourceState) error {
+	_, err := client.GetUser(ctx, rs.Primary.ID)
+	return err
+}
+
+func testAccChangeUserFirstName(t *testing.T, rs *terraform.ResourceState) {
+	ctx := context.Background()
+	client := testAccClient()
+	user, err := client.GetUser(ctx, rs.Primary.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	request := apiclient.UserCreateRequest{
+		UserName:       user.UserName,
+		FirstName:      "Changed outside of Terraform",
+		LastName:       user.LastName,
+		Email:          user.Email,
+		OrganizationId: apiclient.IdOnlyHelper{Id: user.Organization.Id},
+	}
+	for _, role := range user.Roles {
+		request.Roles = append(request.Roles, apiclient.IdOnlyHelper{Id: role.Id})
+	}
+	if _, err = client.UpdateUser(ctx, user.Id, request); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testAccUserConfig(userName string, firstName string, roleId string) string {
+	return testAccProviderConfig() + fmt.Sprintf(`
+resource "coxedge_user" "test" {
+  user_name       = %[1]q
+  first_name      = %[2]q
+  last_name       = "Test"
+  email           = "%[1]s@example.com"
+  organization_id = %[3]q
+  roles {
+    id = %[4]q
+  }
+}
+`, userName, firstName, testAccOrganizationId(), roleId)
+}
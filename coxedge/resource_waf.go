@@ -9,11 +9,11 @@ import (
 	"context"
 	"coxedge/terraform-provider/coxedge/apiclient"
 	"coxedge/terraform-provider/coxedge/utils"
-	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"strconv"
 	"strings"
+	"time"
 )
 
 func resourceWAFSettings() *schema.Resource {
@@ -25,26 +25,27 @@ func resourceWAFSettings() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
-		Schema: getWAFSettingsSchema(),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema:        getWAFSettingsSchema(),
+		CustomizeDiff: customizeDiffProviderDefaults("organization_id", "environment_name"),
 	}
 }
 
 func resourceWAFSettingsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	// Warning or errors can be collected in a slice type
-	var diags diag.Diagnostics
 	//Convert resource data to API object
 	updatedWAFSettings := convertResourceDataToWAFSettingsCreateAPIObject(d)
 	d.SetId(updatedWAFSettings.Id)
 
 	//Run Update since you do not "create" these
-	resourceWAFSettingsUpdate(ctx, d, m)
-
-	return diags
+	return resourceWAFSettingsUpdate(ctx, d, m)
 }
 
 func resourceWAFSettingsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	//Get the API Client
-	coxEdgeClient := m.(apiclient.Client)
+	coxEdgeClient := m.(apiclient.API)
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -61,9 +62,14 @@ func resourceWAFSettingsRead(ctx context.Context, d *schema.ResourceData, m inte
 	resourceId := d.Id()
 
 	//Get the resource
-	wafSettings, err := coxEdgeClient.GetWAFSettings(d.Get("environment_name").(string),
+	wafSettings, err := coxEdgeClient.GetWAFSettings(ctx, d.Get("environment_name").(string),
 		resourceId,
 		d.Get("organization_id").(string))
+	if apiclient.IsNotFound(err) {
+		//Removed outside of Terraform, drop it from state so it is recreated
+		d.SetId("")
+		return diags
+	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -74,22 +80,26 @@ func resourceWAFSettingsRead(ctx context.Context, d *schema.ResourceData, m inte
 
 func resourceWAFSettingsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	//Get the API Client
-	coxEdgeClient := m.(apiclient.Client)
+	coxEdgeClient := m.(apiclient.API)
 	//Convert resource data to API object
 	updatedWAFSettings := convertResourceDataToWAFSettingsCreateAPIObject(d)
 
 	//Call the API
-	taskResp, err := coxEdgeClient.UpdateWAFSettings(updatedWAFSettings.Id, updatedWAFSettings, d.Get("organization_id").(string))
+	taskResp, err := coxEdgeClient.UpdateWAFSettings(ctx, updatedWAFSettings.Id, updatedWAFSettings, d.Get("organization_id").(string))
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	//Await
-	taskResult, err := coxEdgeClient.AwaitTaskResolveWithDefaults(ctx, taskResp.TaskId)
+	//Settings are "created" through an update, so wait as long as the running operation allows
+	operation, timeout := "update", d.Timeout(schema.TimeoutUpdate)
+	if d.IsNewResource() {
+		operation, timeout = "create", d.Timeout(schema.TimeoutCreate)
+	}
+	_, err = coxEdgeClient.AwaitTaskResolveWithTimeout(ctx, taskResp.TaskId, timeout)
 	if err != nil {
-		return diag.FromErr(err)
+		return taskDiagnostics(err, operation, "coxedge_waf_settings", d.Get("site_id").(string))
 	}
-	fmt.Println(taskResult)
 
 	//Set last_updated
 	return resourceWAFSettingsRead(ctx, d, m)
@@ -97,7 +107,12 @@ func resourceWAFSettingsUpdate(ctx context.Context, d *schema.ResourceData, m in
 
 func resourceWAFSettingsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	// Warning or errors can be collected in a slice type
-	return diag.Errorf("Cannot delete WAF")
+	var diags diag.Diagnostics
+
+	//WAF settings live as long as their site, so destroying them only removes them from state
+	d.SetId("")
+
+	return diags
 }
 
 func convertWAFSettingsAPIObjectToResourceData(d *schema.ResourceData, wafSettings *apiclient.WAFSettings) {
@@ -587,7 +602,7 @@ func mapOswapThreats(entry map[string]interface{}) apiclient.WAFOwaspThreats {
 			case "xss_attack":
 				{
 					boolValue, _ := strconv.ParseBool(value.(string))
-					oswapThreats.XmlExternalEntity = utils.BoolAddr(boolValue)
+					oswapThreats.XSSAttack = utils.BoolAddr(boolValue)
 				}
 				break
 			case "shell_shock_attack":
@@ -0,0 +1,110 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+package coxedge
+
+import (
+	"context"
+	"coxedge/terraform-provider/coxedge/apiclient"
+	"coxedge/terraform-provider/coxedge/utils"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"testing"
+)
+
+func TestAccWAFSettings(t *testing.T) {
+	siteDomain := testAccName("tf-acc-site") + ".example.com"
+	resourceName := "coxedge_waf_settings.test"
+	var rs *terraform.ResourceState
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		//Settings live as long as their site, destroying them only drops them from state
+		CheckDestroy: testAccCheckDestroyed("coxedge_site", testAccSiteExists),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWAFSettingsConfig(siteDomain, "false", 110),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "site_id", "coxedge_site.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "monitoring_mode_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "api_urls.0", "/api/v1"),
+					resource.TestCheckResourceAttr(resourceName, "ddos_settings.0.burst_threshold", "110"),
+					resource.TestCheckResourceAttr(resourceName, "owasp_threats.0.sql_injection", "true"),
+					resource.TestCheckResourceAttr(resourceName, "owasp_threats.0.xss_attack", "true"),
+				),
+			},
+			{
+				Config: testAccWAFSettingsConfig(siteDomain, "true", 150),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "monitoring_mode_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "ddos_settings.0.burst_threshold", "150"),
+					testAccStoreResourceState(resourceName, &rs),
+				),
+			},
+			{
+				Config:   testAccWAFSettingsConfig(siteDomain, "true", 150),
+				PlanOnly: true,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccCompositeImportId(resourceName, false),
+				ImportStateVerify: true,
+			},
+			{
+				//Settings changed outside of Terraform are planned to be changed back
+				PreConfig: func() {
+					testAccChangeWAFSettingsMonitoringMode(t, rs)
+				},
+				Config:             testAccWAFSettingsConfig(siteDomain, "true", 150),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccChangeWAFSettingsMonitoringMode(t *testing.T, rs *terraform.ResourceState) {
+	settings := apiclient.WAFSettings{EnvironmentName: rs.Primary.Attributes["environment_name"], MonitoringModeEnabled: utils.BoolAddr(false)}
+	_, err := testAccClient().UpdateWAFSettings(context.Background(), rs.Primary.ID, settings, rs.Primary.Attributes["organization_id"])
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testAccWAFSettingsConfig(siteDomain string, monitoringMode string, burstThreshold int) string {
+	return testAccProviderConfig() + testAccSiteResource(siteDomain, "") + fmt.Sprintf(`
+resource "coxedge_waf_settings" "test" {
+  organization_id         = coxedge_site.test.organization_id
+  environment_name        = coxedge_site.test.environment_name
+  site_id                 = coxedge_site.test.id
+  domain                  = coxedge_site.test.domain
+  monitoring_mode_enabled = %q
+  api_urls                = ["/api/v1"]
+  ddos_settings {
+    burst_threshold           = %d
+    global_threshold          = 500
+    subsecond_burst_threshold = 50
+  }
+  owasp_threats {
+    sql_injection = "true"
+    xss_attack    = "true"
+  }
+}
+`, monitoringMode, burstThreshold)
+}
+
+func TestMapOswapThreatsXSSAttack(t *testing.T) {
+	threats := mapOswapThreats(map[string]interface{}{"xss_attack": "true"})
+
+	if threats.XSSAttack == nil || !*threats.XSSAttack {
+		t.Errorf("expected xss_attack to set the XSS attack rule, got %v", threats.XSSAttack)
+	}
+	if threats.XmlExternalEntity != nil {
+		t.Errorf("expected the XML external entity rule to stay unset, got %v", *threats.XmlExternalEntity)
+	}
+}
@@ -13,6 +13,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"strings"
+	"time"
 )
 
 func resourceWorkload() *schema.Resource {
@@ -24,13 +26,19 @@ func resourceWorkload() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
-		Schema: getWorkloadSchema(),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema:        getWorkloadSchema(),
+		CustomizeDiff: customizeDiffProviderDefaults("organization_id", "environment_name"),
 	}
 }
 
 func resourceWorkloadCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	//Get the API Client
-	coxEdgeClient := m.(apiclient.Client)
+	coxEdgeClient := m.(apiclient.API)
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -49,38 +57,56 @@ func resourceWorkloadCreate(ctx context.Context, d *schema.ResourceData, m inter
 	organizationId := d.Get("organization_id").(string)
 
 	//Call the API
-	createdWorkload, err := coxEdgeClient.CreateWorkload(newWorkload, organizationId)
+	createdWorkload, err := coxEdgeClient.CreateWorkload(ctx, newWorkload, organizationId)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	tflog.Info(ctx, "Initiated Create. Awaiting task result.")
 
-	//Await
-	taskResult, err := coxEdgeClient.AwaitTaskResolveWithDefaults(ctx, createdWorkload.TaskId)
-	if err != nil {
-		return diag.FromErr(err)
+	//Await, recording the task in state so an interrupted apply can resume it
+	if diags = awaitCreateTask(ctx, d, coxEdgeClient, createdWorkload.TaskId, "coxedge_workload", d.Get("name").(string)); diags != nil {
+		return diags
 	}
 
-	//Save the Id
-	d.SetId(taskResult.Data.Result.Id)
-
-	return diags
+	return resourceWorkloadRead(ctx, d, m)
 }
 
 func resourceWorkloadRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	//Get the API Client
-	coxEdgeClient := m.(apiclient.Client)
+	coxEdgeClient := m.(apiclient.API)
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
+	//check the id comes with id, environment_name & organization_id, then split the value -> in case of importing the resource
+	//format is <workload_id>:<environment_name>:<organization_id>
+	if strings.Contains(d.Id(), ":") {
+		keys := strings.Split(d.Id(), ":")
+		if len(keys) != 3 {
+			return diag.Errorf("unexpected import ID %q, expected <workload_id>:<environment_name>:<organization_id>", d.Id())
+		}
+		d.SetId(keys[0])
+		d.Set("environment_name", keys[1])
+		d.Set("organization_id", keys[2])
+	}
+
+	//Resume a create that was interrupted while awaiting its task
+	if diags = resumePendingTask(ctx, d, coxEdgeClient, "coxedge_workload", d.Get("name").(string)); diags.HasError() || d.Id() == "" {
+		return diags
+	}
+
 	//Get the resource Id
 	resourceId := d.Id()
 	organizationId := d.Get("organization_id").(string)
 
 	//Get the resource
-	workload, err := coxEdgeClient.GetWorkload(d.Get("environment_name").(string), resourceId, organizationId)
+	workload, err := coxEdgeClient.GetWorkload(ctx, d.Get("environment_name").(string), resourceId, organizationId)
+	if apiclient.IsNotFound(err) {
+		//Removed outside of Terraform, drop it from state so it is recreated
+		d.SetId("")
+		return diags
+	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -93,7 +119,7 @@ func resourceWorkloadRead(ctx context.Context, d *schema.ResourceData, m interfa
 
 func resourceWorkloadUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	//Get the API Client
-	coxEdgeClient := m.(apiclient.Client)
+	coxEdgeClient := m.(apiclient.API)
 
 	//Get the resource Id
 	resourceId := d.Id()
@@ -103,7 +129,7 @@ func resourceWorkloadUpdate(ctx context.Context, d *schema.ResourceData, m inter
 	organizationId := d.Get("organization_id").(string)
 
 	//Call the API
-	createdWorkload, err := coxEdgeClient.UpdateWorkload(resourceId, updatedWorkload, organizationId)
+	createdWorkload, err := coxEdgeClient.UpdateWorkload(ctx, resourceId, updatedWorkload, organizationId)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -111,9 +137,9 @@ func resourceWorkloadUpdate(ctx context.Context, d *schema.ResourceData, m inter
 	tflog.Info(ctx, "Initiated Update. Awaiting task result.")
 
 	//Await
-	taskResult, err := coxEdgeClient.AwaitTaskResolveWithDefaults(ctx, createdWorkload.TaskId)
+	taskResult, err := coxEdgeClient.AwaitTaskResolveWithTimeout(ctx, createdWorkload.TaskId, d.Timeout(schema.TimeoutUpdate))
 	if err != nil {
-		return diag.FromErr(err)
+		return taskDiagnostics(err, "update", "coxedge_workload", d.Get("name").(string))
 	}
 
 	//Set last_updated
@@ -129,14 +155,19 @@ func resourceWorkloadDelete(ctx context.Context, d *schema.ResourceData, m inter
 	var diags diag.Diagnostics
 
 	//Get the API Client
-	coxEdgeClient := m.(apiclient.Client)
+	coxEdgeClient := m.(apiclient.API)
+
+	//Resolve an interrupted create first, so the created object is deleted rather than the task
+	if diags = resumePendingTask(ctx, d, coxEdgeClient, "coxedge_workload", d.Get("name").(string)); diags.HasError() || d.Id() == "" {
+		return diags
+	}
 
 	//Get the resource Id
 	resourceId := d.Id()
 	organizationId := d.Get("organization_id").(string)
 
 	//Delete the Workload
-	err := coxEdgeClient.DeleteWorkload(d.Get("environment_name").(string), resourceId, organizationId)
+	err := coxEdgeClient.DeleteWorkload(ctx, d.Get("environment_name").(string), resourceId, organizationId)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -255,7 +286,7 @@ func convertWorkloadAPIObjectToResourceData(d *schema.ResourceData, workload *ap
 		item["cpu_utilization"] = deployment.CPUUtilization
 		deployments[i] = item
 	}
-	d.Set("deployments", deployments)
+	d.Set("deployment", deployments)
 
 	ports := make([]map[string]string, len(workload.Ports), len(workload.Ports))
 	for i, portObj := range workload.Ports {
@@ -0,0 +1,175 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+package coxedge
+
+import (
+	"context"
+	"coxedge/terraform-provider/coxedge/apiclient"
+	"coxedge/terraform-provider/coxedge/apiclient/mock"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"testing"
+)
+
+func TestAccWorkload(t *testing.T) {
+	name := testAccName("tf-acc-workload")
+	resourceName := "coxedge_workload.test"
+	var rs *terraform.ResourceState
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckDestroyed("coxedge_workload", testAccWorkloadExists),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkloadConfig(name, 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "type", "CONTAINER"),
+					resource.TestCheckResourceAttr(resourceName, "deployment.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "deployment.0.instances_per_pop", "1"),
+				),
+			},
+			{
+				Config: testAccWorkloadConfig(name, 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "deployment.0.instances_per_pop", "2"),
+					testAccStoreResourceState(resourceName, &rs),
+				),
+			},
+			{
+				Config:   testAccWorkloadConfig(name, 2),
+				PlanOnly: true,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccCompositeImportId(resourceName, false),
+				ImportStateVerify: true,
+				//Create only argument the API does not return
+				ImportStateVerifyIgnore: []string{"add_anycast_ip_address", "pending_task_id"},
+			},
+			{
+				//A workload deleted outside of Terraform is planned to be created again
+				PreConfig: func() {
+					testAccDeleteWorkload(t, rs)
+				},
+				Config:             testAccWorkloadConfig(name, 2),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccWorkloadExists(ctx context.Context, client *apiclient.Client, rs *terraform.ResourceState) error {
+	_, err := client.GetWorkload(ctx, rs.Primary.Attributes["environment_name"], rs.Primary.ID, rs.Primary.Attributes["organization_id"])
+	return err
+}
+
+func testAccDeleteWorkload(t *testing.T, rs *terraform.ResourceState) {
+	err := testAccClient().DeleteWorkload(context.Background(), rs.Primary.Attributes["environment_name"], rs.Primary.ID, rs.Primary.Attributes["organization_id"])
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+//testAccWorkloadResource Container workload other acceptance tests attach their resources to
+func testAccWorkloadResource(name string, instancesPerPop int) string {
+	return fmt.Sprintf(`
+resource "coxedge_workload" "test" {
+  organization_id  = %q
+  environment_name = %q
+  name             = %q
+  type             = "CONTAINER"
+  image            = "bitnami/nginx"
+  specs            = "SP-2"
+  deployment {
+    name               = "test"
+    enable_autoscaling = false
+    pops               = ["BTR"]
+    instances_per_pop  = %d
+  }
+}
+`, testAccOrganizationId(), testAccEnvironmentName(), name, instancesPerPop)
+}
+
+func testAccWorkloadConfig(name string, instancesPerPop int) string {
+	return testAccProviderConfig() + testAccWorkloadResource(name, instancesPerPop)
+}
+
+func TestResourceWorkloadRead(t *testing.T) {
+	testCases := []struct {
+		name        string
+		id          string
+		workload    *apiclient.Workload
+		err         error
+		expectId    string
+		expectName  string
+		expectError bool
+	}{
+		{
+			name:       "updates state",
+			id:         "workload-1",
+			workload:   &apiclient.Workload{Id: "workload-1", Name: "web", Type: "CONTAINER"},
+			expectId:   "workload-1",
+			expectName: "web",
+		},
+		{
+			name:       "splits import ID",
+			id:         "workload-1:env-1:org-1",
+			workload:   &apiclient.Workload{Id: "workload-1", Name: "web", Type: "CONTAINER"},
+			expectId:   "workload-1",
+			expectName: "web",
+		},
+		{
+			name:     "drops deleted workload",
+			id:       "workload-1",
+			err:      &apiclient.APIError{StatusCode: 404},
+			expectId: "",
+		},
+		{
+			name:        "reports API errors",
+			id:          "workload-1",
+			err:         errors.New("connection refused"),
+			expectId:    "workload-1",
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			client := &mock.APIMock{
+				GetWorkloadFunc: func(ctx context.Context, environmentName string, id string, organizationId string) (*apiclient.Workload, error) {
+					return tc.workload, tc.err
+				},
+			}
+			d := schema.TestResourceDataRaw(t, getWorkloadSchema(), map[string]interface{}{
+				"organization_id":  "org-1",
+				"environment_name": "env-1",
+			})
+			d.SetId(tc.id)
+
+			diags := resourceWorkloadRead(context.Background(), d, client)
+			if diags.HasError() != tc.expectError {
+				t.Fatalf("unexpected diagnostics %v", diags)
+			}
+			if d.Id() != tc.expectId {
+				t.Errorf("expected id %q, got %q", tc.expectId, d.Id())
+			}
+			if tc.expectName != "" && d.Get("name").(string) != tc.expectName {
+				t.Errorf("expected name %q, got %q", tc.expectName, d.Get("name"))
+			}
+			calls := client.GetWorkloadCalls()
+			if len(calls) != 1 || calls[0].ID != "workload-1" || calls[0].EnvironmentName != "env-1" || calls[0].OrganizationId != "org-1" {
+				t.Errorf("unexpected GetWorkload calls %+v", calls)
+			}
+		})
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"strconv"
 )
 
@@ -164,20 +165,301 @@ func getEnvironmentSetSchema() map[string]*schema.Schema {
 	}
 }
 
-func getWorkloadInstanceSetSchema() map[string]*schema.Schema {
+//getDataSourceScopeSchema Environment and organization a data source reads from, defaulting to the provider ones
+func getDataSourceScopeSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
-		"id": {
+		"environment_name": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Defaults to the provider `environment_name`.",
+		},
+		"organization_id": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Defaults to the provider `organization_id`.",
+		},
+	}
+}
+
+//getListDataSourceSchema Schema of a data source listing objects of the element schema under key, narrowed by the
+//required filter attributes
+func getListDataSourceSchema(key string, element map[string]*schema.Schema, filters ...string) map[string]*schema.Schema {
+	dataSourceSchema := getDataSourceScopeSchema()
+	for _, filter := range filters {
+		dataSourceSchema[filter] = &schema.Schema{
 			Type:     schema.TypeString,
 			Required: true,
+		}
+	}
+	dataSourceSchema[key] = &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: element,
 		},
-		"environment_name": {
+	}
+	return dataSourceSchema
+}
+
+//getLookupDataSourceSchema Schema of a data source finding the single object of the element schema that matches the
+//required lookup attributes
+func getLookupDataSourceSchema(element map[string]*schema.Schema, lookups ...string) map[string]*schema.Schema {
+	dataSourceSchema := getDataSourceScopeSchema()
+	for key, value := range element {
+		dataSourceSchema[key] = value
+	}
+	for _, lookup := range lookups {
+		dataSourceSchema[lookup] = &schema.Schema{
 			Type:     schema.TypeString,
 			Required: true,
+		}
+	}
+	return dataSourceSchema
+}
+
+func getSiteDataSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Type:     schema.TypeString,
+			Computed: true,
 		},
-		"organization_id": {
+		"stack_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"domain": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"status": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"created_at": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"updated_at": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"services": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"edge_address": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"anycast_ip": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"delivery_domains": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"domain": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"validated_at": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func getWorkloadDataSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"stack_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"slug": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"type": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"image": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"specs": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"cpu": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"memory": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"network": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"anycast_ip_address": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"status": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"version": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"created": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func getScriptDataSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"stack_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"site_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"created_at": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"updated_at": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"code": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"version": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"routes": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+	}
+}
+
+func getFirewallRuleDataSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"site_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"action": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"enabled": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"ip_start": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"ip_end": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func getDeliveryDomainDataSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"stack_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"site_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"domain": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"updated_at": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func getWorkloadInstanceSetSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
 			Type:     schema.TypeString,
 			Required: true,
 		},
+		"environment_name": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Defaults to the provider `environment_name`.",
+		},
+		"organization_id": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Defaults to the provider `organization_id`.",
+		},
 		"workload_instances": {
 			Type:     schema.TypeList,
 			Computed: true,
@@ -270,6 +552,47 @@ func getRolesSchema() map[string]*schema.Schema {
 	}
 }
 
+func getRoleSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"description": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"organization_id": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			ForceNew:    true,
+			Description: "Defaults to the provider `organization_id`.",
+		},
+		"default_scope": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Scope the role is granted in, such as `ORGANIZATION` or `ENVIRONMENT`.",
+		},
+		"permissions": {
+			Type: schema.TypeSet,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+			Optional: true,
+		},
+		"is_system": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"last_updated": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+}
+
 func getEnvironmentSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"id": {
@@ -290,8 +613,10 @@ func getEnvironmentSchema() map[string]*schema.Schema {
 			Optional: true,
 		},
 		"organization_id": {
-			Type:     schema.TypeString,
-			Required: true,
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Defaults to the provider `organization_id`.",
 		},
 		"service_connection_id": {
 			Type:     schema.TypeString,
@@ -325,6 +650,34 @@ func getEnvironmentSchema() map[string]*schema.Schema {
 				},
 			},
 		},
+		"last_updated": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func getEnvironmentMemberSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"environment_id": {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		"user_id": {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		"role_name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Name of the environment role the user is bound to.",
+		},
+		"role_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
 	}
 }
 
@@ -347,8 +700,10 @@ func getUserSchema() map[string]*schema.Schema {
 			Required: true,
 		},
 		"organization_id": {
-			Type:     schema.TypeString,
-			Required: true,
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Defaults to the provider `organization_id`.",
 		},
 		"roles": {
 			Type: schema.TypeList,
@@ -370,6 +725,41 @@ func getUserSchema() map[string]*schema.Schema {
 	}
 }
 
+func getUserActionSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"user_id": {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		"action": {
+			Type:             schema.TypeString,
+			Required:         true,
+			ForceNew:         true,
+			Description:      "The action to run, only `unlock` is supported.",
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"unlock"}, false)),
+		},
+		"triggers": {
+			Type: schema.TypeMap,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+			Optional:    true,
+			ForceNew:    true,
+			Description: "Arbitrary values that run the action again when changed.",
+		},
+		"user_status": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Status of the user right after the action ran.",
+		},
+		"performed_at": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+}
+
 func getWorkloadSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"id": {
@@ -377,12 +767,16 @@ func getWorkloadSchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 		"organization_id": {
-			Type:     schema.TypeString,
-			Required: true,
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Defaults to the provider `organization_id`.",
 		},
 		"environment_name": {
-			Type:     schema.TypeString,
-			Required: true,
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Defaults to the provider `environment_name`.",
 		},
 		"name": {
 			Type:     schema.TypeString,
@@ -534,6 +928,11 @@ func getWorkloadSchema() map[string]*schema.Schema {
 			Type:     schema.TypeString,
 			Optional: true,
 		},
+		"pending_task_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "ID of the create task still being awaited. Set only while an interrupted create is resumed.",
+		},
 	}
 }
 
@@ -663,12 +1062,16 @@ func getNetworkPolicyRuleSchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 		"organization_id": {
-			Type:     schema.TypeString,
-			Required: true,
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Defaults to the provider `organization_id`.",
 		},
 		"environment_name": {
-			Type:     schema.TypeString,
-			Required: true,
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Defaults to the provider `environment_name`.",
 		},
 		"network_policy": {
 			Type:     schema.TypeList,
@@ -715,18 +1118,26 @@ func getNetworkPolicyRuleSchema() map[string]*schema.Schema {
 				},
 			},
 		},
+		"last_updated": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
 	}
 }
 
 func getSiteSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"organization_id": {
-			Type:     schema.TypeString,
-			Required: true,
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Defaults to the provider `organization_id`.",
 		},
 		"environment_name": {
-			Type:     schema.TypeString,
-			Required: true,
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Defaults to the provider `environment_name`.",
 		},
 		"services": {
 			Type: schema.TypeList,
@@ -810,6 +1221,14 @@ func getSiteSchema() map[string]*schema.Schema {
 			Type:     schema.TypeString,
 			Computed: true,
 		},
+		"created_at": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"updated_at": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
 		"edge_address": {
 			Type:     schema.TypeString,
 			Computed: true,
@@ -836,6 +1255,15 @@ func getSiteSchema() map[string]*schema.Schema {
 				},
 			},
 		},
+		"pending_task_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "ID of the create task still being awaited. Set only while an interrupted create is resumed.",
+		},
+		"last_updated": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
 	}
 }
 
@@ -846,12 +1274,16 @@ func getOriginSettingSetSchema() map[string]*schema.Schema {
 			Required: true,
 		},
 		"environment_name": {
-			Type:     schema.TypeString,
-			Required: true,
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Defaults to the provider `environment_name`.",
 		},
 		"organization_id": {
-			Type:     schema.TypeString,
-			Required: true,
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Defaults to the provider `organization_id`.",
 		},
 		"origin_settings": &schema.Schema{
 			Type:     schema.TypeList,
@@ -900,8 +1332,10 @@ func getOriginSettingsSchema() map[string]*schema.Schema {
 			Optional: true,
 		},
 		"organization_id": {
-			Type:     schema.TypeString,
-			Required: true,
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Defaults to the provider `organization_id`.",
 		},
 		"site_id": {
 			Type:     schema.TypeString,
@@ -916,8 +1350,10 @@ func getOriginSettingsSchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 		"environment_name": {
-			Type:     schema.TypeString,
-			Required: true,
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Defaults to the provider `environment_name`.",
 		},
 		"domain": {
 			Type:     schema.TypeString,
@@ -1008,6 +1444,10 @@ func getOriginSettingsSchema() map[string]*schema.Schema {
 			},
 			Optional: true,
 		},
+		"last_updated": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
 	}
 }
 
@@ -1018,16 +1458,20 @@ func getDeliveryDomainSchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 		"organization_id": {
-			Type:     schema.TypeString,
-			Required: true,
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Defaults to the provider `organization_id`.",
 		},
 		"stack_id": {
 			Type:     schema.TypeString,
 			Computed: true,
 		},
 		"environment_name": {
-			Type:     schema.TypeString,
-			Required: true,
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Defaults to the provider `environment_name`.",
 		},
 		"domain": {
 			Type:     schema.TypeString,
@@ -1037,22 +1481,35 @@ func getDeliveryDomainSchema() map[string]*schema.Schema {
 			Type:     schema.TypeString,
 			Required: true,
 		},
+		"updated_at": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"pending_task_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "ID of the create task still being awaited. Set only while an interrupted create is resumed.",
+		},
 	}
 }
 
 func getCDNSettingsSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"organization_id": {
-			Type:     schema.TypeString,
-			Required: true,
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Defaults to the provider `organization_id`.",
 		},
 		"site_id": {
 			Type:     schema.TypeString,
 			Required: true,
 		},
 		"environment_name": {
-			Type:     schema.TypeString,
-			Required: true,
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Defaults to the provider `environment_name`.",
 		},
 		"cache_expire_policy": {
 			Type:     schema.TypeString,
@@ -1284,9 +1741,11 @@ func getCDNSettingsSchema() map[string]*schema.Schema {
 func getCDNPurgeResourceSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"organization_id": {
-			Type:     schema.TypeString,
-			Required: true,
-			ForceNew: true,
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			ForceNew:    true,
+			Description: "Defaults to the provider `organization_id`.",
 		},
 		"site_id": {
 			Type:     schema.TypeString,
@@ -1294,9 +1753,11 @@ func getCDNPurgeResourceSchema() map[string]*schema.Schema {
 			ForceNew: true,
 		},
 		"environment_name": {
-			Type:     schema.TypeString,
-			Required: true,
-			ForceNew: true,
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			ForceNew:    true,
+			Description: "Defaults to the provider `environment_name`.",
 		},
 		"purge_type": {
 			Type:     schema.TypeString,
@@ -1313,22 +1774,27 @@ func getCDNPurgeResourceSchema() map[string]*schema.Schema {
 					"url": {
 						Type:     schema.TypeString,
 						Required: true,
+						ForceNew: true,
 					},
 					"recursive": {
 						Type:     schema.TypeBool,
 						Optional: true,
+						ForceNew: true,
 					},
 					"invalidate_only": {
 						Type:     schema.TypeBool,
 						Optional: true,
+						ForceNew: true,
 					},
 					"purge_all_dynamic": {
 						Type:     schema.TypeBool,
 						Optional: true,
+						ForceNew: true,
 					},
 					"headers": {
 						Type:     schema.TypeList,
 						Optional: true,
+						ForceNew: true,
 						Elem: &schema.Schema{
 							Type: schema.TypeString,
 						},
@@ -1336,24 +1802,29 @@ func getCDNPurgeResourceSchema() map[string]*schema.Schema {
 					"purge_selector": {
 						Type:     schema.TypeList,
 						Optional: true,
+						ForceNew: true,
 						MaxItems: 1,
 						Elem: &schema.Resource{
 							Schema: map[string]*schema.Schema{
 								"selector_name": {
 									Type:     schema.TypeString,
 									Optional: true,
+									ForceNew: true,
 								},
 								"selector_type": {
 									Type:     schema.TypeString,
 									Optional: true,
+									ForceNew: true,
 								},
 								"selector_value": {
 									Type:     schema.TypeString,
 									Optional: true,
+									ForceNew: true,
 								},
 								"selector_value_delimiter": {
 									Type:     schema.TypeString,
 									Optional: true,
+									ForceNew: true,
 								},
 							},
 						},
@@ -1367,13 +1838,16 @@ func getCDNPurgeResourceSchema() map[string]*schema.Schema {
 func getWAFSettingsSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"organization_id": {
-			Type:     schema.TypeString,
-			Required: true,
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Defaults to the provider `organization_id`.",
 		},
 		"environment_name": {
 			Type:        schema.TypeString,
-			Required:    true,
-			Description: "Environment name ",
+			Optional:    true,
+			Computed:    true,
+			Description: "Defaults to the provider `environment_name`.",
 		},
 		"site_id": {
 			Type:     schema.TypeString,
@@ -1386,6 +1860,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 		"domain": {
 			Type:     schema.TypeString,
 			Optional: true,
+			Computed: true,
 		},
 		"api_urls": {
 			Type: schema.TypeList,
@@ -1393,10 +1868,12 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 				Type: schema.TypeString,
 			},
 			Optional: true,
+			Computed: true,
 		},
 		"ddos_settings": {
 			Type:     schema.TypeList,
 			Optional: true,
+			Computed: true,
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
 					"global_threshold": {
@@ -1417,6 +1894,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 		"monitoring_mode_enabled": {
 			Type:     schema.TypeString,
 			Optional: true,
+			Computed: true,
 			ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 				var diags diag.Diagnostics
 				value := i.(string)
@@ -1435,11 +1913,13 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 		"owasp_threats": {
 			Type:     schema.TypeList,
 			Optional: true,
+			Computed: true,
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
 					"sql_injection": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -1458,6 +1938,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"xss_attack": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -1476,6 +1957,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"shell_shock_attack": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -1494,6 +1976,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"remote_file_inclusion": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -1512,6 +1995,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"apache_struts_exploit": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -1530,6 +2014,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"local_file_inclusion": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -1548,6 +2033,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"common_web_application_vulnerabilities": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -1566,6 +2052,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"webshell_execution_attempt": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -1584,6 +2071,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"protocol_attack": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -1602,6 +2090,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"csrf": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -1620,6 +2109,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"open_redirect": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -1638,6 +2128,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"shell_injection": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -1656,6 +2147,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"code_injection": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -1674,6 +2166,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"sensitive_data_exposure": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -1692,6 +2185,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"xml_external_entity": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -1710,6 +2204,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"personal_identifiable_info": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -1728,6 +2223,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"serverside_template_injection": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -1749,11 +2245,13 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 		"general_policies": {
 			Type:     schema.TypeList,
 			Optional: true,
+			Computed: true,
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
 					"block_invalid_user_agents": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -1772,6 +2270,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"block_unknown_user_agents": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -1790,6 +2289,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"http_method_validation": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -1811,11 +2311,13 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 		"traffic_sources": {
 			Type:     schema.TypeList,
 			Optional: true,
+			Computed: true,
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
 					"via_tor_nodes": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -1834,6 +2336,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"via_proxy_networks": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -1852,6 +2355,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"via_hosting_services": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -1870,6 +2374,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"via_vpn": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -1888,6 +2393,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"convicted_bot_traffic": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -1906,6 +2412,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"traffic_from_suspicious_nat_ranges": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -1924,6 +2431,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"external_reputation_block_list": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -1942,6 +2450,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"traffic_via_cdn": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -1963,11 +2472,13 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 		"anti_automation_bot_protection": {
 			Type:     schema.TypeList,
 			Optional: true,
+			Computed: true,
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
 					"force_browser_validation_on_traffic_anomalies": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -1986,6 +2497,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"challenge_automated_clients": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -2004,6 +2516,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"challenge_headless_browsers": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -2022,6 +2535,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"anti_scraping": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -2043,11 +2557,13 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 		"behavioral_waf": {
 			Type:     schema.TypeList,
 			Optional: true,
+			Computed: true,
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
 					"spam_protection": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -2066,6 +2582,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"block_probing_and_forced_browsing": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -2084,6 +2601,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"obfuscated_attacks_and_zeroday_mitigation": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -2102,6 +2620,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"repeated_violations": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -2120,6 +2639,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"bruteforce_protection": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -2141,11 +2661,13 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 		"cms_protection": {
 			Type:     schema.TypeList,
 			Optional: true,
+			Computed: true,
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
 					"wordpress_waf_ruleset": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -2164,6 +2686,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"whitelist_wordpress": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -2182,6 +2705,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"whitelist_modx": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -2200,6 +2724,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"whitelist_drupal": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -2218,6 +2743,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"whitelist_joomla": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -2236,6 +2762,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"whitelist_magento": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -2254,6 +2781,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"whitelist_origin_ip": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -2272,6 +2800,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"whitelist_umbraco": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -2293,11 +2822,13 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 		"allow_known_bots": {
 			Type:     schema.TypeList,
 			Optional: true,
+			Computed: true,
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
 					"acquia_uptime": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -2316,6 +2847,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"add_search_bot": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -2334,6 +2866,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"adestra_bot": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -2352,6 +2885,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"adjust_servers": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -2370,6 +2904,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"ahrefs_bot": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -2388,6 +2923,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"alerta_bot": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -2406,6 +2942,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"alexa_ia_archiver": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -2424,6 +2961,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"alexa_technologies": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -2442,6 +2980,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"amazon_route_53_health_check_service": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -2460,6 +2999,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"applebot": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -2478,6 +3018,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"apple_news_bot": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -2496,6 +3037,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"ask_jeeves_bot": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -2514,6 +3056,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"audisto_bot": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -2532,6 +3075,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"baidu_spider_bot": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -2550,6 +3094,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"baidu_spider_japan_bot": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -2568,6 +3113,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"binary_canary": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -2586,6 +3132,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"bitbucket_webhook": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -2604,6 +3151,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"blekko_scout_jet_bot": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -2622,6 +3170,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"chrome_compression_proxy": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -2640,6 +3189,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"coccocbot": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -2658,6 +3208,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"cookie_bot": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -2676,6 +3227,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"cybersource": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -2694,6 +3246,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"daumoa_bot": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -2712,6 +3265,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"detectify_scanner": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -2730,6 +3284,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"digi_cert_dcv_bot": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -2748,6 +3303,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"dotmic_dot_bot_commercial": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -2766,6 +3322,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"duck_duck_go_bot": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -2784,6 +3341,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"facebook_external_hit_bot": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -2802,6 +3360,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"feeder_co": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -2820,6 +3379,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"feed_press": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -2838,6 +3398,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"feed_wind": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -2856,6 +3417,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"freshping_monitoring": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -2874,6 +3436,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"geckoboard": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -2892,6 +3455,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"ghost_inspector": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -2910,6 +3474,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"gomez": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -2928,6 +3493,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"goo_japan_bot": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -2946,6 +3512,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"google_ads_bot": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -2964,6 +3531,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"google_bot": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -2982,6 +3550,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"google_cloud_monitoring_bot": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -3000,6 +3569,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"google_feed_fetcher_bot": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -3018,6 +3588,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"google_image_bot": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -3036,6 +3607,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"google_image_proxy": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -3054,6 +3626,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"google_mediapartners_bot": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -3072,6 +3645,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"google_mobile_ads_bot": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -3090,6 +3664,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"google_news_bot": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -3108,6 +3683,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"google_page_speed_insights": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -3126,6 +3702,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"google_structured_data_testing_tool": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -3144,6 +3721,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"google_verification_bot": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -3162,6 +3740,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"google_video_bot": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -3180,6 +3759,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"google_web_light": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -3198,6 +3778,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"grapeshot_bot_commercial": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -3216,6 +3797,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"gree_japan_bot": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -3234,6 +3816,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"hetrix_tools": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -3252,6 +3835,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"hi_pay": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -3270,6 +3854,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"hyperspin_bot": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -3288,6 +3873,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"ias_crawler_commercial": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -3306,6 +3892,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"internet_archive_bot": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -3324,6 +3911,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"jetpack_bot": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -3342,6 +3930,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"jike_spider_bot": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -3360,6 +3949,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"j_word_japan_bot": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -3378,6 +3968,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"kakao_user_agent": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -3396,6 +3987,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"kyoto_tohoku_crawler": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -3414,6 +4006,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"landau_media_spider": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -3432,6 +4025,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"lets_encrypt": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -3450,6 +4044,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"line_japan_bot": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -3468,6 +4063,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"linked_in_bot": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -3486,6 +4082,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"livedoor_japan_bot": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -3504,6 +4101,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"mail_ru_bot": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -3522,6 +4120,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"manage_wp": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -3540,6 +4139,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"microsoft_bing_bot": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -3558,6 +4158,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"microsoft_bing_preview_bot": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -3576,6 +4177,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"microsoft_msn_bot": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -3594,6 +4196,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"microsoft_skype_bot": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -3612,6 +4215,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"mixi_japan_bot": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -3630,6 +4234,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"mobage_japan_bot": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -3648,6 +4253,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"naver_yeti_bot": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -3666,6 +4272,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"new_relic_bot": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -3684,6 +4291,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"ocn_japan_bot": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -3702,6 +4310,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"panopta_bot": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -3720,6 +4329,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"parse_ly_scraper": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -3738,6 +4348,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"pay_pal_ipn": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -3756,6 +4367,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"petal_bot": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -3774,6 +4386,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"pingdom": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -3792,6 +4405,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"pinterest_bot": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -3810,6 +4424,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"qwantify_bot": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -3828,6 +4443,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"roger_bot": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -3846,6 +4462,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"sage_pay": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -3864,6 +4481,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"sectigo_bot": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -3882,6 +4500,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"semrush_bot": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -3900,6 +4519,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"server_density_service_monitoring_bot": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -3918,6 +4538,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"seznam_bot": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -3936,6 +4557,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"shareaholic_bot": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -3954,6 +4576,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"site_24_x_7_bot": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -3972,6 +4595,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"siteimprove_bot": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -3990,6 +4614,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"site_lock_spider": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -4008,6 +4633,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"slack_bot": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -4026,6 +4652,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"sogou_bot": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -4044,6 +4671,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"soso_spider_bot": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -4062,6 +4690,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"spatineo": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -4080,6 +4709,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"spring_bot": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -4098,6 +4728,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"stackify": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -4116,6 +4747,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"status_cake_bot": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -4134,6 +4766,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"stripe": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -4152,6 +4785,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"sucuri_uptime_monitor_bot": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -4170,6 +4804,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"telegram_bot": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -4188,6 +4823,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"testomato_bot": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -4206,6 +4842,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"the_find_crawler": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -4224,6 +4861,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"twitter_bot": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -4242,6 +4880,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"uptime_robot": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -4260,6 +4899,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"vkontakte_external_hit_bot": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -4278,6 +4918,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"w_3_c": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -4296,6 +4937,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"wordfence_central": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -4314,6 +4956,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"workato": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -4332,6 +4975,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"xml_sitemaps": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -4350,6 +4994,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"yahoo_inktomi_slurp_bot": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -4368,6 +5013,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"yahoo_japan_bot": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -4386,6 +5032,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"yahoo_link_preview": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -4404,6 +5051,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"yahoo_seeker_bot": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -4422,6 +5070,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"yahoo_slurp_bot": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -4440,6 +5089,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"yandex_bot": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -4458,6 +5108,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"yisou_spider_commercial": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -4476,6 +5127,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"yodao_bot": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -4494,6 +5146,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"zendesk_bot": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -4512,6 +5165,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"zoho_bot": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -4530,6 +5184,7 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 					"zum_bot": {
 						Type:     schema.TypeString,
 						Optional: true,
+						Computed: true,
 						ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 							var diags diag.Diagnostics
 							value := i.(string)
@@ -4551,6 +5206,144 @@ func getWAFSettingsSchema() map[string]*schema.Schema {
 	}
 }
 
+func getEdgeLogicSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"organization_id": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Defaults to the provider `organization_id`.",
+		},
+		"environment_name": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Defaults to the provider `environment_name`.",
+		},
+		"site_id": {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		"stack_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"scope_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"force_www_enabled": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+			ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
+				var diags diag.Diagnostics
+				value := i.(string)
+				_, err := strconv.ParseBool(value)
+				if err != nil {
+					diag := diag.Diagnostic{
+						Severity: diag.Error,
+						Summary:  "wrong value",
+						Detail:   fmt.Sprintf("%q is not %q", value, "Boolean value"),
+					}
+					diags = append(diags, diag)
+				}
+				return diags
+			},
+		},
+		"pseudo_streaming_enabled": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+			ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
+				var diags diag.Diagnostics
+				value := i.(string)
+				_, err := strconv.ParseBool(value)
+				if err != nil {
+					diag := diag.Diagnostic{
+						Severity: diag.Error,
+						Summary:  "wrong value",
+						Detail:   fmt.Sprintf("%q is not %q", value, "Boolean value"),
+					}
+					diags = append(diags, diag)
+				}
+				return diags
+			},
+		},
+		"referrer_protection_enabled": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+			ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
+				var diags diag.Diagnostics
+				value := i.(string)
+				_, err := strconv.ParseBool(value)
+				if err != nil {
+					diag := diag.Diagnostic{
+						Severity: diag.Error,
+						Summary:  "wrong value",
+						Detail:   fmt.Sprintf("%q is not %q", value, "Boolean value"),
+					}
+					diags = append(diags, diag)
+				}
+				return diags
+			},
+		},
+		"allow_empty_referrer": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+			ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
+				var diags diag.Diagnostics
+				value := i.(string)
+				_, err := strconv.ParseBool(value)
+				if err != nil {
+					diag := diag.Diagnostic{
+						Severity: diag.Error,
+						Summary:  "wrong value",
+						Detail:   fmt.Sprintf("%q is not %q", value, "Boolean value"),
+					}
+					diags = append(diags, diag)
+				}
+				return diags
+			},
+		},
+		"referrer_list": {
+			Type: schema.TypeList,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+			Optional: true,
+			Computed: true,
+		},
+		"robot_txt_enabled": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+			ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
+				var diags diag.Diagnostics
+				value := i.(string)
+				_, err := strconv.ParseBool(value)
+				if err != nil {
+					diag := diag.Diagnostic{
+						Severity: diag.Error,
+						Summary:  "wrong value",
+						Detail:   fmt.Sprintf("%q is not %q", value, "Boolean value"),
+					}
+					diags = append(diags, diag)
+				}
+				return diags
+			},
+		},
+		"robot_txt_file": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+	}
+}
+
 func getFirewallRuleSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"id": {
@@ -4558,12 +5351,16 @@ func getFirewallRuleSchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 		"organization_id": {
-			Type:     schema.TypeString,
-			Required: true,
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Defaults to the provider `organization_id`.",
 		},
 		"environment_name": {
-			Type:     schema.TypeString,
-			Required: true,
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Defaults to the provider `environment_name`.",
 		},
 		"site_id": {
 			Type:     schema.TypeString,
@@ -4590,6 +5387,15 @@ func getFirewallRuleSchema() map[string]*schema.Schema {
 			Type:     schema.TypeString,
 			Optional: true,
 		},
+		"pending_task_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "ID of the create task still being awaited. Set only while an interrupted create is resumed.",
+		},
+		"last_updated": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
 	}
 }
 
@@ -4600,8 +5406,10 @@ func getScriptSchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 		"organization_id": {
-			Type:     schema.TypeString,
-			Required: true,
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Defaults to the provider `organization_id`.",
 		},
 		"stack_id": {
 			Type:     schema.TypeString,
@@ -4612,8 +5420,10 @@ func getScriptSchema() map[string]*schema.Schema {
 			Required: true,
 		},
 		"environment_name": {
-			Type:     schema.TypeString,
-			Required: true,
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Defaults to the provider `environment_name`.",
 		},
 		"name": {
 			Type:     schema.TypeString,
@@ -4642,5 +5452,14 @@ func getScriptSchema() map[string]*schema.Schema {
 			},
 			Required: true,
 		},
+		"pending_task_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "ID of the create task still being awaited. Set only while an interrupted create is resumed.",
+		},
+		"last_updated": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
 	}
 }
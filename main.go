@@ -14,10 +14,13 @@ import (
 // Generate the Terraform provider documentation using `tfplugindocs`:
 //go:generate go run github.com/hashicorp/terraform-plugin-docs/cmd/tfplugindocs
 
+//version Provider version sent in the User-Agent, set at build time with -ldflags "-X main.version=<version>"
+var version = "dev"
+
 func main() {
 	plugin.Serve(&plugin.ServeOpts{
 		ProviderFunc: func() *schema.Provider {
-			return coxedge.Provider()
+			return coxedge.NewProvider(version)
 		},
 	})
 }